@@ -33,7 +33,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	dieRollClient, err := examplev1.NewDieRollClient(c)
+	dieRollClient, err := examplev1.NewDieRollClient(c, nil)
 	if err != nil {
 		logger.Error("could not create client", "error", err)
 		os.Exit(1)
@@ -90,7 +90,9 @@ func main() {
 	}()
 
 	_, err = untilRun.Result(ctx)
-	if err != nil {
+	if details, ok := examplev1.AsInvalidTargetValueError(err); ok {
+		logger.Error("invalid target value", "value", details.GetValue())
+	} else if err != nil {
 		logger.Error("workflow failed", "error", err)
 	}
 }
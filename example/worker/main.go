@@ -70,7 +70,8 @@ func (s *DieRollService) ParentWorkflow(ctx workflow.Context, req *emptypb.Empty
 func (s *DieRollService) ChildWorkflow(ctx workflow.Context, req *emptypb.Empty) (*emptypb.Empty, error) {
 	info := workflow.GetInfo(ctx)
 
-	err := s.c.SendSignalContinue(context.Background(), info.ParentWorkflowExecution.ID, info.ParentWorkflowExecution.RunID, &examplev1.ContinueSignalRequest{
+	parent := examplev1.GetExternalParentWorkflow(ctx, info.ParentWorkflowExecution.ID, info.ParentWorkflowExecution.RunID)
+	err := parent.SignalContinue(ctx, &examplev1.ContinueSignalRequest{
 		Continue: true,
 	})
 	if err != nil {
@@ -106,11 +107,38 @@ func (s *DieRollService) ThrowUntilValue(ctx workflow.Context, req *examplev1.Th
 	throws := int32(0)
 
 	// Sends query updates
-	examplev1.HandleQueryGetThrowsStatus(ctx, func(req *emptypb.Empty) (*examplev1.ThrowStatusResponse, error) {
+	err := examplev1.HandleQueryGetThrowsStatus(ctx, func(req *emptypb.Empty) (*examplev1.ThrowStatusResponse, error) {
 		return &examplev1.ThrowStatusResponse{
 			Throws: throws,
 		}, nil
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	target := req.Value
+	if target < 0 || target > 5 {
+		return nil, examplev1.NewInvalidTargetValueError("invalid target value", &examplev1.InvalidTargetValue{
+			Value: target,
+		}, true)
+	}
+
+	// Lets the target value be changed while the workflow runs
+	err = examplev1.HandleUpdateChangeTargetValue(ctx, func(ctx workflow.Context, req *examplev1.ChangeTargetValueRequest) (*examplev1.ChangeTargetValueResponse, error) {
+		previous := target
+		target = req.Value
+		return &examplev1.ChangeTargetValueResponse{
+			PreviousValue: previous,
+		}, nil
+	}, func(ctx workflow.Context, req *examplev1.ChangeTargetValueRequest) error {
+		if req.Value < 0 || req.Value > 5 {
+			return fmt.Errorf("invalid target value %d", req.Value)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	for {
 		val, err := s.c.ExecuteActivityThrowDieSync(ctx, &emptypb.Empty{})
@@ -118,12 +146,19 @@ func (s *DieRollService) ThrowUntilValue(ctx workflow.Context, req *examplev1.Th
 			return nil, err
 		}
 
-		if val.Result == req.Value {
+		if val.Result == target {
 			break
 		}
 
 		throws++
 
+		// Keeps the history short by starting over with the current target
+		if examplev1.ThrowUntilValueContinueAsNewSuggested(ctx) {
+			return nil, examplev1.NewThrowUntilValueContinueAsNewError(ctx, &examplev1.ThrowUntilValueRequest{
+				Value: target,
+			})
+		}
+
 		workflow.Sleep(ctx, time.Second*5)
 	}
 
@@ -149,7 +184,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	dieRollClient, err := examplev1.NewDieRollClient(c)
+	dieRollClient, err := examplev1.NewDieRollClient(c, nil)
 	if err != nil {
 		logger.Error("could not create client", "error", err)
 		os.Exit(1)
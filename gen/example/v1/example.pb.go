@@ -71,6 +71,60 @@ func (Status) EnumDescriptor() ([]byte, []int) {
 	return file_example_v1_example_proto_rawDescGZIP(), []int{0}
 }
 
+// Errors a die throw can fail with
+type DieError int32
+
+const (
+	DieError_DIE_ERROR_UNSPECIFIED DieError = 0
+	DieError_DIE_ERROR_LOST        DieError = 1
+	DieError_DIE_ERROR_CRACKED     DieError = 2
+	// the die can be thrown again
+	DieError_DIE_ERROR_ROLLED_OFF_TABLE DieError = 3
+)
+
+// Enum value maps for DieError.
+var (
+	DieError_name = map[int32]string{
+		0: "DIE_ERROR_UNSPECIFIED",
+		1: "DIE_ERROR_LOST",
+		2: "DIE_ERROR_CRACKED",
+		3: "DIE_ERROR_ROLLED_OFF_TABLE",
+	}
+	DieError_value = map[string]int32{
+		"DIE_ERROR_UNSPECIFIED":      0,
+		"DIE_ERROR_LOST":             1,
+		"DIE_ERROR_CRACKED":          2,
+		"DIE_ERROR_ROLLED_OFF_TABLE": 3,
+	}
+)
+
+func (x DieError) Enum() *DieError {
+	p := new(DieError)
+	*p = x
+	return p
+}
+
+func (x DieError) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DieError) Descriptor() protoreflect.EnumDescriptor {
+	return file_example_v1_example_proto_enumTypes[1].Descriptor()
+}
+
+func (DieError) Type() protoreflect.EnumType {
+	return &file_example_v1_example_proto_enumTypes[1]
+}
+
+func (x DieError) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DieError.Descriptor instead.
+func (DieError) EnumDescriptor() ([]byte, []int) {
+	return file_example_v1_example_proto_rawDescGZIP(), []int{1}
+}
+
 // Instructs the workflow to continue or stop
 type ContinueSignalRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -262,7 +316,9 @@ type ThrowDiesRequest struct {
 	// A deprecated field
 	//
 	// Deprecated: Marked as deprecated in example/v1/example.proto.
-	ResultStatus  string `protobuf:"bytes,3,opt,name=result_status,json=resultStatus,proto3" json:"result_status,omitempty"`
+	ResultStatus string `protobuf:"bytes,3,opt,name=result_status,json=resultStatus,proto3" json:"result_status,omitempty"`
+	// Name of the player, encrypted in the workflow history
+	PlayerName    string `protobuf:"bytes,4,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -319,6 +375,13 @@ func (x *ThrowDiesRequest) GetResultStatus() string {
 	return ""
 }
 
+func (x *ThrowDiesRequest) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
 // Requests  to roll a die until a certain value is pulled
 type ThrowUntilValueRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -365,6 +428,144 @@ func (x *ThrowUntilValueRequest) GetValue() int32 {
 	return 0
 }
 
+// Returned by ThrowUntilValue when the target cannot be thrown
+type InvalidTargetValue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Target value that was requested
+	Value         int32 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvalidTargetValue) Reset() {
+	*x = InvalidTargetValue{}
+	mi := &file_example_v1_example_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidTargetValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidTargetValue) ProtoMessage() {}
+
+func (x *InvalidTargetValue) ProtoReflect() protoreflect.Message {
+	mi := &file_example_v1_example_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidTargetValue.ProtoReflect.Descriptor instead.
+func (*InvalidTargetValue) Descriptor() ([]byte, []int) {
+	return file_example_v1_example_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *InvalidTargetValue) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// Changes the target value of a ThrowUntilValue workflow
+type ChangeTargetValueRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// New target value
+	Value         int32 `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeTargetValueRequest) Reset() {
+	*x = ChangeTargetValueRequest{}
+	mi := &file_example_v1_example_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeTargetValueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeTargetValueRequest) ProtoMessage() {}
+
+func (x *ChangeTargetValueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_example_v1_example_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeTargetValueRequest.ProtoReflect.Descriptor instead.
+func (*ChangeTargetValueRequest) Descriptor() ([]byte, []int) {
+	return file_example_v1_example_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ChangeTargetValueRequest) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+// Returns the previous target value
+type ChangeTargetValueResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Previous target value
+	PreviousValue int32 `protobuf:"varint,1,opt,name=previous_value,json=previousValue,proto3" json:"previous_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeTargetValueResponse) Reset() {
+	*x = ChangeTargetValueResponse{}
+	mi := &file_example_v1_example_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeTargetValueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeTargetValueResponse) ProtoMessage() {}
+
+func (x *ChangeTargetValueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_example_v1_example_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeTargetValueResponse.ProtoReflect.Descriptor instead.
+func (*ChangeTargetValueResponse) Descriptor() ([]byte, []int) {
+	return file_example_v1_example_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ChangeTargetValueResponse) GetPreviousValue() int32 {
+	if x != nil {
+		return x.PreviousValue
+	}
+	return 0
+}
+
 // Response to a die roll request
 type ThrowStatusResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -376,7 +577,7 @@ type ThrowStatusResponse struct {
 
 func (x *ThrowStatusResponse) Reset() {
 	*x = ThrowStatusResponse{}
-	mi := &file_example_v1_example_proto_msgTypes[6]
+	mi := &file_example_v1_example_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -388,7 +589,7 @@ func (x *ThrowStatusResponse) String() string {
 func (*ThrowStatusResponse) ProtoMessage() {}
 
 func (x *ThrowStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_example_v1_example_proto_msgTypes[6]
+	mi := &file_example_v1_example_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -401,7 +602,7 @@ func (x *ThrowStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ThrowStatusResponse.ProtoReflect.Descriptor instead.
 func (*ThrowStatusResponse) Descriptor() ([]byte, []int) {
-	return file_example_v1_example_proto_rawDescGZIP(), []int{6}
+	return file_example_v1_example_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ThrowStatusResponse) GetThrows() int32 {
@@ -421,7 +622,7 @@ type ParentWorkflowReply struct {
 
 func (x *ParentWorkflowReply) Reset() {
 	*x = ParentWorkflowReply{}
-	mi := &file_example_v1_example_proto_msgTypes[7]
+	mi := &file_example_v1_example_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -433,7 +634,7 @@ func (x *ParentWorkflowReply) String() string {
 func (*ParentWorkflowReply) ProtoMessage() {}
 
 func (x *ParentWorkflowReply) ProtoReflect() protoreflect.Message {
-	mi := &file_example_v1_example_proto_msgTypes[7]
+	mi := &file_example_v1_example_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -446,7 +647,7 @@ func (x *ParentWorkflowReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ParentWorkflowReply.ProtoReflect.Descriptor instead.
 func (*ParentWorkflowReply) Descriptor() ([]byte, []int) {
-	return file_example_v1_example_proto_rawDescGZIP(), []int{7}
+	return file_example_v1_example_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ParentWorkflowReply) GetStatus() Status {
@@ -477,85 +678,117 @@ var file_example_v1_example_proto_rawDesc = []byte{
 	0x74, 0x22, 0x2d, 0x0a, 0x11, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x44, 0x69, 0x65, 0x73, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
 	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
-	0x22, 0x69, 0x0a, 0x10, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x44, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x6c, 0x6f, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x6f,
-	0x6f, 0x70, 0x12, 0x27, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0c, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2e, 0x0a, 0x16, 0x54,
-	0x68, 0x72, 0x6f, 0x77, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2d, 0x0a, 0x13, 0x54,
-	0x68, 0x72, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x68, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x06, 0x74, 0x68, 0x72, 0x6f, 0x77, 0x73, 0x22, 0x41, 0x0a, 0x13, 0x50, 0x61,
-	0x72, 0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x12, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a, 0x31, 0x0a,
-	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x44, 0x45, 0x46,
-	0x49, 0x4e, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53,
-	0x53, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02,
-	0x32, 0xe5, 0x05, 0x0a, 0x07, 0x44, 0x69, 0x65, 0x52, 0x6f, 0x6c, 0x6c, 0x12, 0x6b, 0x0a, 0x08,
-	0x54, 0x68, 0x72, 0x6f, 0x77, 0x44, 0x69, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68,
-	0x72, 0x6f, 0x77, 0x44, 0x69, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29,
-	0x82, 0xb5, 0x18, 0x25, 0x10, 0x78, 0x18, 0x78, 0x20, 0x1e, 0x2a, 0x1d, 0x08, 0x01, 0x15, 0x00,
-	0x00, 0xc0, 0x3f, 0x18, 0x0a, 0x20, 0x0a, 0x2a, 0x05, 0x46, 0x41, 0x54, 0x41, 0x4c, 0x2a, 0x09,
-	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x12, 0x49, 0x0a, 0x04, 0x50, 0x69, 0x6e,
-	0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x11, 0x82, 0xb5, 0x18, 0x0d, 0x0a, 0x09, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x69,
-	0x6e, 0x67, 0x30, 0x3c, 0x12, 0x59, 0x0a, 0x0e, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x57, 0x6f,
-	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f,
-	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x65,
-	0x6e, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x0e, 0x8a, 0xb5, 0x18, 0x0a, 0x32, 0x08, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x12,
-	0x45, 0x0a, 0x0d, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
-	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x04, 0x8a, 0xb5, 0x18, 0x00, 0x12, 0x58, 0x0a, 0x09, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x44,
-	0x69, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x44, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x68, 0x72, 0x6f, 0x77, 0x44, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x0e, 0x8a, 0xb5, 0x18, 0x0a, 0x32, 0x08, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65,
-	0x12, 0x64, 0x0a, 0x0f, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x22, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x15, 0x8a, 0xb5, 0x18, 0x11, 0x3a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x73,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4b, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e,
-	0x75, 0x65, 0x12, 0x21, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x04, 0x92,
-	0xb5, 0x18, 0x00, 0x12, 0x50, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x73,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f,
-	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68, 0x72, 0x6f,
-	0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x04, 0x9a, 0xb5, 0x18, 0x00, 0x1a, 0x21, 0x92, 0xb5, 0x18, 0x1d, 0x0a, 0x12, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2d, 0x74, 0x61, 0x73, 0x6b, 0x2d, 0x71, 0x75, 0x65, 0x75, 0x65, 0x12,
-	0x07, 0x10, 0x80, 0xa3, 0x05, 0x18, 0xa0, 0x38, 0x42, 0xaf, 0x01, 0x0a, 0x0e, 0x63, 0x6f, 0x6d,
-	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x45, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x6d, 0x61, 0x73, 0x2d, 0x6d,
-	0x61, 0x75, 0x72, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65,
-	0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x74, 0x6d, 0x70, 0x72, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x65,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0a, 0x45, 0x78, 0x61, 0x6d,
-	0x70, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0a, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x16, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5c, 0x56, 0x31,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0b, 0x45,
-	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x22, 0x90, 0x01, 0x0a, 0x10, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x44, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c,
+	0x6f, 0x6f, 0x70, 0x12, 0x27, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0c,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0b,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x04, 0x90, 0xb5, 0x18, 0x01, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x22, 0x2e, 0x0a, 0x16, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x55, 0x6e, 0x74, 0x69,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x2a, 0x0a, 0x12, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0x30, 0x0a, 0x18, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x22, 0x42, 0x0a, 0x19, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25,
+	0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2d, 0x0a, 0x13, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x68, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x68,
+	0x72, 0x6f, 0x77, 0x73, 0x22, 0x41, 0x0a, 0x13, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x57, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2a, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2a, 0x31, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x0b, 0x0a,
+	0x07, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x02, 0x2a, 0x78, 0x0a, 0x08, 0x44, 0x69,
+	0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x49, 0x45, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x49, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x4c,
+	0x4f, 0x53, 0x54, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x49, 0x45, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x43, 0x52, 0x41, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x02, 0x12, 0x26, 0x0a, 0x1a,
+	0x44, 0x49, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x45, 0x44,
+	0x5f, 0x4f, 0x46, 0x46, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x1a, 0x06, 0x82, 0xb5,
+	0x18, 0x02, 0x08, 0x01, 0x32, 0xa2, 0x07, 0x0a, 0x07, 0x44, 0x69, 0x65, 0x52, 0x6f, 0x6c, 0x6c,
+	0x12, 0x63, 0x0a, 0x08, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x44, 0x69, 0x65, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x44, 0x69, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x21, 0x82, 0xb5, 0x18, 0x1d, 0x10, 0x78, 0x18, 0x78, 0x20, 0x1e, 0x2a, 0x15,
+	0x08, 0x01, 0x15, 0x00, 0x00, 0xc0, 0x3f, 0x18, 0x0a, 0x20, 0x0a, 0x32, 0x08, 0x44, 0x69, 0x65,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x49, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x11, 0x82,
+	0xb5, 0x18, 0x0d, 0x0a, 0x09, 0x70, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x30, 0x3c,
+	0x12, 0x59, 0x0a, 0x0e, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c,
+	0x6f, 0x77, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x57, 0x6f,
+	0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x0e, 0x8a, 0xb5, 0x18,
+	0x0a, 0x32, 0x08, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x12, 0x4b, 0x0a, 0x0d, 0x43,
+	0x68, 0x69, 0x6c, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x0a, 0x8a, 0xb5,
+	0x18, 0x06, 0x80, 0x01, 0x03, 0x88, 0x01, 0x01, 0x12, 0x58, 0x0a, 0x09, 0x54, 0x68, 0x72, 0x6f,
+	0x77, 0x44, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x44, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x44, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x0e, 0x8a, 0xb5, 0x18, 0x0a, 0x32, 0x08, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e,
+	0x75, 0x65, 0x12, 0xba, 0x01, 0x0a, 0x0f, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x55, 0x6e, 0x74, 0x69,
+	0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x22, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x6b, 0x8a, 0xb5, 0x18, 0x67, 0x3a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72,
+	0x6f, 0x77, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4a, 0x09, 0x12, 0x03,
+	0x08, 0x90, 0x1c, 0x18, 0x3c, 0x20, 0x01, 0x52, 0x19, 0x74, 0x68, 0x72, 0x6f, 0x77, 0x2d, 0x75,
+	0x6e, 0x74, 0x69, 0x6c, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2f, 0x7b, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x7d, 0x60, 0x02, 0x70, 0xe8, 0x07, 0x92, 0x01, 0x12, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0xb0, 0x01, 0x01, 0x12,
+	0x4b, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x12, 0x21, 0x2e, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75,
+	0x65, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x04, 0x92, 0xb5, 0x18, 0x00, 0x12, 0x50, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68, 0x72, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x04, 0x9a, 0xb5, 0x18, 0x00, 0x12, 0x66,
+	0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x24, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x04, 0xa2, 0xb5, 0x18, 0x00, 0x1a, 0x21, 0x92, 0xb5, 0x18, 0x1d, 0x0a, 0x12, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2d, 0x74, 0x61, 0x73, 0x6b, 0x2d, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x12, 0x07, 0x10, 0x80, 0xa3, 0x05, 0x18, 0xa0, 0x38, 0x42, 0xaf, 0x01, 0x0a, 0x0e, 0x63, 0x6f,
+	0x6d, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x45, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x46, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74, 0x68, 0x6f, 0x6d, 0x61, 0x73, 0x2d,
+	0x6d, 0x61, 0x75, 0x72, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67,
+	0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x74, 0x6d, 0x70, 0x72, 0x6c, 0x2f, 0x67, 0x65, 0x6e, 0x2f,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x45, 0x58, 0x58, 0xaa, 0x02, 0x0a, 0x45, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0a, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x16, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0b,
+	0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -570,43 +803,49 @@ func file_example_v1_example_proto_rawDescGZIP() []byte {
 	return file_example_v1_example_proto_rawDescData
 }
 
-var file_example_v1_example_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_example_v1_example_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_example_v1_example_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_example_v1_example_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_example_v1_example_proto_goTypes = []any{
-	(Status)(0),                    // 0: example.v1.Status
-	(*ContinueSignalRequest)(nil),  // 1: example.v1.ContinueSignalRequest
-	(*GetStatusResponse)(nil),      // 2: example.v1.GetStatusResponse
-	(*ThrowDieResponse)(nil),       // 3: example.v1.ThrowDieResponse
-	(*ThrowDiesResponse)(nil),      // 4: example.v1.ThrowDiesResponse
-	(*ThrowDiesRequest)(nil),       // 5: example.v1.ThrowDiesRequest
-	(*ThrowUntilValueRequest)(nil), // 6: example.v1.ThrowUntilValueRequest
-	(*ThrowStatusResponse)(nil),    // 7: example.v1.ThrowStatusResponse
-	(*ParentWorkflowReply)(nil),    // 8: example.v1.ParentWorkflowReply
-	(*emptypb.Empty)(nil),          // 9: google.protobuf.Empty
+	(Status)(0),                       // 0: example.v1.Status
+	(DieError)(0),                     // 1: example.v1.DieError
+	(*ContinueSignalRequest)(nil),     // 2: example.v1.ContinueSignalRequest
+	(*GetStatusResponse)(nil),         // 3: example.v1.GetStatusResponse
+	(*ThrowDieResponse)(nil),          // 4: example.v1.ThrowDieResponse
+	(*ThrowDiesResponse)(nil),         // 5: example.v1.ThrowDiesResponse
+	(*ThrowDiesRequest)(nil),          // 6: example.v1.ThrowDiesRequest
+	(*ThrowUntilValueRequest)(nil),    // 7: example.v1.ThrowUntilValueRequest
+	(*InvalidTargetValue)(nil),        // 8: example.v1.InvalidTargetValue
+	(*ChangeTargetValueRequest)(nil),  // 9: example.v1.ChangeTargetValueRequest
+	(*ChangeTargetValueResponse)(nil), // 10: example.v1.ChangeTargetValueResponse
+	(*ThrowStatusResponse)(nil),       // 11: example.v1.ThrowStatusResponse
+	(*ParentWorkflowReply)(nil),       // 12: example.v1.ParentWorkflowReply
+	(*emptypb.Empty)(nil),             // 13: google.protobuf.Empty
 }
 var file_example_v1_example_proto_depIdxs = []int32{
-	0, // 0: example.v1.ParentWorkflowReply.status:type_name -> example.v1.Status
-	9, // 1: example.v1.DieRoll.ThrowDie:input_type -> google.protobuf.Empty
-	9, // 2: example.v1.DieRoll.Ping:input_type -> google.protobuf.Empty
-	9, // 3: example.v1.DieRoll.ParentWorkflow:input_type -> google.protobuf.Empty
-	9, // 4: example.v1.DieRoll.ChildWorkflow:input_type -> google.protobuf.Empty
-	5, // 5: example.v1.DieRoll.ThrowDies:input_type -> example.v1.ThrowDiesRequest
-	6, // 6: example.v1.DieRoll.ThrowUntilValue:input_type -> example.v1.ThrowUntilValueRequest
-	1, // 7: example.v1.DieRoll.Continue:input_type -> example.v1.ContinueSignalRequest
-	9, // 8: example.v1.DieRoll.GetThrowsStatus:input_type -> google.protobuf.Empty
-	3, // 9: example.v1.DieRoll.ThrowDie:output_type -> example.v1.ThrowDieResponse
-	9, // 10: example.v1.DieRoll.Ping:output_type -> google.protobuf.Empty
-	8, // 11: example.v1.DieRoll.ParentWorkflow:output_type -> example.v1.ParentWorkflowReply
-	9, // 12: example.v1.DieRoll.ChildWorkflow:output_type -> google.protobuf.Empty
-	4, // 13: example.v1.DieRoll.ThrowDies:output_type -> example.v1.ThrowDiesResponse
-	9, // 14: example.v1.DieRoll.ThrowUntilValue:output_type -> google.protobuf.Empty
-	9, // 15: example.v1.DieRoll.Continue:output_type -> google.protobuf.Empty
-	7, // 16: example.v1.DieRoll.GetThrowsStatus:output_type -> example.v1.ThrowStatusResponse
-	9, // [9:17] is the sub-list for method output_type
-	1, // [1:9] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0,  // 0: example.v1.ParentWorkflowReply.status:type_name -> example.v1.Status
+	13, // 1: example.v1.DieRoll.ThrowDie:input_type -> google.protobuf.Empty
+	13, // 2: example.v1.DieRoll.Ping:input_type -> google.protobuf.Empty
+	13, // 3: example.v1.DieRoll.ParentWorkflow:input_type -> google.protobuf.Empty
+	13, // 4: example.v1.DieRoll.ChildWorkflow:input_type -> google.protobuf.Empty
+	6,  // 5: example.v1.DieRoll.ThrowDies:input_type -> example.v1.ThrowDiesRequest
+	7,  // 6: example.v1.DieRoll.ThrowUntilValue:input_type -> example.v1.ThrowUntilValueRequest
+	2,  // 7: example.v1.DieRoll.Continue:input_type -> example.v1.ContinueSignalRequest
+	13, // 8: example.v1.DieRoll.GetThrowsStatus:input_type -> google.protobuf.Empty
+	9,  // 9: example.v1.DieRoll.ChangeTargetValue:input_type -> example.v1.ChangeTargetValueRequest
+	4,  // 10: example.v1.DieRoll.ThrowDie:output_type -> example.v1.ThrowDieResponse
+	13, // 11: example.v1.DieRoll.Ping:output_type -> google.protobuf.Empty
+	12, // 12: example.v1.DieRoll.ParentWorkflow:output_type -> example.v1.ParentWorkflowReply
+	13, // 13: example.v1.DieRoll.ChildWorkflow:output_type -> google.protobuf.Empty
+	5,  // 14: example.v1.DieRoll.ThrowDies:output_type -> example.v1.ThrowDiesResponse
+	13, // 15: example.v1.DieRoll.ThrowUntilValue:output_type -> google.protobuf.Empty
+	13, // 16: example.v1.DieRoll.Continue:output_type -> google.protobuf.Empty
+	11, // 17: example.v1.DieRoll.GetThrowsStatus:output_type -> example.v1.ThrowStatusResponse
+	10, // 18: example.v1.DieRoll.ChangeTargetValue:output_type -> example.v1.ChangeTargetValueResponse
+	10, // [10:19] is the sub-list for method output_type
+	1,  // [1:10] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_example_v1_example_proto_init() }
@@ -619,8 +858,8 @@ func file_example_v1_example_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_example_v1_example_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   8,
+			NumEnums:      2,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
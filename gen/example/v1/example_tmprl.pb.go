@@ -10,14 +10,25 @@ package examplev1
 
 import (
 	context "context"
+	aes "crypto/aes"
+	cipher "crypto/cipher"
+	rand "crypto/rand"
+	errors "errors"
 	fmt "fmt"
 	uuid "github.com/google/uuid"
+	v11 "go.temporal.io/api/common/v1"
+	v1 "go.temporal.io/api/enums/v1"
 	activity "go.temporal.io/sdk/activity"
 	client "go.temporal.io/sdk/client"
+	converter "go.temporal.io/sdk/converter"
 	temporal "go.temporal.io/sdk/temporal"
 	worker "go.temporal.io/sdk/worker"
 	workflow "go.temporal.io/sdk/workflow"
+	proto "google.golang.org/protobuf/proto"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	io "io"
+	http "net/http"
+	debug "runtime/debug"
 	time "time"
 )
 
@@ -53,6 +64,11 @@ const ( // Default task queue name for the service
 
 	// Name of query example.v1.DieRoll.GetThrowsStatus
 	QueryDieRollGetThrowsStatusName = "example.v1.DieRoll.GetThrowsStatus"
+
+	// Updates names constants
+
+	// Name of update example.v1.DieRoll.ChangeTargetValue
+	UpdateDieRollChangeTargetValueName = "example.v1.DieRoll.ChangeTargetValue"
 )
 
 // DieRollService is the interface your service must implement
@@ -99,6 +115,7 @@ type DieRollWorker struct {
 	client client.Client
 	worker worker.Worker
 	svc    DieRollService
+	dialed bool
 }
 
 // NewDieRollWorker: Returns a new instance of the worker.
@@ -121,68 +138,433 @@ func NewDieRollWorker(client client.Client, svc DieRollService, taskQueue string
 
 // Register registers the worker and its activities/workflows in temporal
 func (w *DieRollWorker) Register() {
+	RegisterDieRollService(w.worker, w.svc)
+}
+
+// Start will run the worker in a non-blocking fashion. Use Stop() to stop the worker.
+func (w *DieRollWorker) Start() error {
+	return w.worker.Start()
+}
+
+// Run will run the worker until interruptCh receives a signal. Use worker.InterruptCh() to interrupt when there's an interrupt signal from the OS.
+func (w *DieRollWorker) Run(interruptCh <-chan any) error {
+	return w.worker.Run(interruptCh)
+}
+
+// Stop will stop the worker, may panic if called twice
+func (w *DieRollWorker) Stop() {
+	w.worker.Stop()
+}
+
+// RegisterDieRollService registers the workflows and activities of `svc` in a worker or a test environment
+func RegisterDieRollService(r worker.Registry, svc DieRollService) {
 	// Registers activity ThrowDie
-	w.worker.RegisterActivityWithOptions(w.svc.ThrowDie, activity.RegisterOptions{
+	r.RegisterActivityWithOptions(svc.ThrowDie, activity.RegisterOptions{
 		Name: "example.v1.DieRoll.ThrowDie",
 	})
 	// Registers activity Ping
-	w.worker.RegisterActivityWithOptions(w.svc.Ping, activity.RegisterOptions{
+	r.RegisterActivityWithOptions(svc.Ping, activity.RegisterOptions{
 		Name: "ping.Ping",
 	})
 	// Registers workflow ParentWorkflow
-	w.worker.RegisterWorkflowWithOptions(w.svc.ParentWorkflow, workflow.RegisterOptions{
+	r.RegisterWorkflowWithOptions(svc.ParentWorkflow, workflow.RegisterOptions{
 		Name: "example.v1.DieRoll.ParentWorkflow",
 	})
 	// Registers workflow ChildWorkflow
-	w.worker.RegisterWorkflowWithOptions(w.svc.ChildWorkflow, workflow.RegisterOptions{
+	r.RegisterWorkflowWithOptions(svc.ChildWorkflow, workflow.RegisterOptions{
 		Name: "example.v1.DieRoll.ChildWorkflow",
 	})
 	// Registers workflow ThrowDies
-	w.worker.RegisterWorkflowWithOptions(w.svc.ThrowDies, workflow.RegisterOptions{
+	r.RegisterWorkflowWithOptions(svc.ThrowDies, workflow.RegisterOptions{
 		Name: "example.v1.DieRoll.ThrowDies",
 	})
 	// Registers workflow ThrowUntilValue
-	w.worker.RegisterWorkflowWithOptions(w.svc.ThrowUntilValue, workflow.RegisterOptions{
+	r.RegisterWorkflowWithOptions(func(ctx workflow.Context, req *ThrowUntilValueRequest) (*emptypb.Empty, error) {
+		if err := validateDieRollRequest("example.v1.DieRoll.ThrowUntilValue", req); err != nil {
+			return nil, temporal.NewNonRetryableApplicationError(err.Error(), DieRollValidationErrorType, err)
+		}
+		return svc.ThrowUntilValue(ctx, req)
+	}, workflow.RegisterOptions{
 		Name: "example.v1.DieRoll.ThrowUntilValue",
 	})
 }
 
-// Start will run the worker in a non-blocking fashion. Use Stop() to stop the worker.
-func (w *DieRollWorker) Start() error {
-	return w.worker.Start()
+// DieRollServiceMiddleware is called around the workflows and activities of a DieRollService
+// wrapped by WrapDieRollService, with their registered name, request and response.
+// The workflow hooks run within the workflow, replays included, they must be deterministic
+// and only log through workflow.GetLogger or record metrics through workflow.GetMetricsHandler
+type DieRollServiceMiddleware interface {
+	// BeforeActivity is called before an activity runs, returning an error skips it
+	BeforeActivity(ctx context.Context, name string, req proto.Message) (context.Context, error)
+	// AfterActivity is called once an activity returned, `resp` being nil if it returned none, and returns the error to return instead
+	AfterActivity(ctx context.Context, name string, req proto.Message, resp proto.Message, err error) error
+	// BeforeWorkflow is called before a workflow runs, returning an error skips it
+	BeforeWorkflow(ctx workflow.Context, name string, req proto.Message) (workflow.Context, error)
+	// AfterWorkflow is called once a workflow returned, `resp` being nil if it returned none, and returns the error to return instead
+	AfterWorkflow(ctx workflow.Context, name string, req proto.Message, resp proto.Message, err error) error
 }
 
-// Run will run the worker until interruptCh receives a signal. Use worker.InterruptCh() to interrupt when there's an interrupt signal from the OS.
-func (w *DieRollWorker) Run(interruptCh <-chan any) error {
-	return w.worker.Run(interruptCh)
+// DieRollServiceMiddlewareBase is a DieRollServiceMiddleware doing nothing,
+// to be embedded by the middlewares only implementing some of the hooks
+type DieRollServiceMiddlewareBase struct{}
+
+// BeforeActivity returns the context as is
+func (DieRollServiceMiddlewareBase) BeforeActivity(ctx context.Context, name string, req proto.Message) (context.Context, error) {
+	return ctx, nil
 }
 
-// Stop will stop the worker, may panic if called twice
-func (w *DieRollWorker) Stop() {
-	w.worker.Stop()
+// AfterActivity returns the error as is
+func (DieRollServiceMiddlewareBase) AfterActivity(ctx context.Context, name string, req proto.Message, resp proto.Message, err error) error {
+	return err
+}
+
+// BeforeWorkflow returns the context as is
+func (DieRollServiceMiddlewareBase) BeforeWorkflow(ctx workflow.Context, name string, req proto.Message) (workflow.Context, error) {
+	return ctx, nil
+}
+
+// AfterWorkflow returns the error as is
+func (DieRollServiceMiddlewareBase) AfterWorkflow(ctx workflow.Context, name string, req proto.Message, resp proto.Message, err error) error {
+	return err
+}
+
+// DieRollPanicError is handed to the after hooks when a workflow or an activity of the
+// DieRoll service panics, the panic goes on unless a hook returns another error
+type DieRollPanicError struct {
+	// Name is the registered name of the workflow or activity
+	Name string
+	// Value is the value the panic was raised with
+	Value any
+	// Stack is the stack trace of the panic
+	Stack string
+}
+
+func (e *DieRollPanicError) Error() string {
+	return fmt.Sprintf("panic in %s: %v", e.Name, e.Value)
+}
+
+// wrappedDieRollService is a DieRollService running its methods through middlewares
+type wrappedDieRollService struct {
+	svc         DieRollService
+	middlewares []DieRollServiceMiddleware
+}
+
+// WrapDieRollService returns a DieRollService calling the hooks of the middlewares around the
+// workflows and activities of svc, the first middleware being the outermost one
+func WrapDieRollService(svc DieRollService, middlewares ...DieRollServiceMiddleware) DieRollService {
+	return &wrappedDieRollService{
+		middlewares: middlewares,
+		svc:         svc,
+	}
+}
+
+// ThrowDie runs the ThrowDie activity through the middlewares
+func (s *wrappedDieRollService) ThrowDie(ctx context.Context, req *emptypb.Empty) (resp *ThrowDieResponse, err error) {
+	i, returned := 0, false
+	defer func() {
+		r := recover()
+		if r == nil && !returned {
+			// the goroutine exited without returning, nothing to hand to the hooks
+			return
+		}
+		var panicErr *DieRollPanicError
+		if r != nil {
+			panicErr = &DieRollPanicError{
+				Name:  "example.v1.DieRoll.ThrowDie",
+				Stack: string(debug.Stack()),
+				Value: r,
+			}
+			err = panicErr
+		}
+		// a nil response is handed to the hooks as a nil message, not as a typed nil
+		var out proto.Message
+		if resp != nil {
+			out = resp
+		}
+		for i--; i >= 0; i-- {
+			err = s.middlewares[i].AfterActivity(ctx, "example.v1.DieRoll.ThrowDie", req, out, err)
+		}
+		if panicErr != nil && err == panicErr {
+			panic(panicErr.Value)
+		}
+	}()
+
+	for i < len(s.middlewares) {
+		c, err := s.middlewares[i].BeforeActivity(ctx, "example.v1.DieRoll.ThrowDie", req)
+		if err != nil {
+			returned = true
+			return nil, err
+		}
+		ctx = c
+		i++
+	}
+
+	resp, err = s.svc.ThrowDie(ctx, req)
+	returned = true
+	return resp, err
+}
+
+// Ping runs the Ping activity through the middlewares
+func (s *wrappedDieRollService) Ping(ctx context.Context, req *emptypb.Empty) (resp *emptypb.Empty, err error) {
+	i, returned := 0, false
+	defer func() {
+		r := recover()
+		if r == nil && !returned {
+			// the goroutine exited without returning, nothing to hand to the hooks
+			return
+		}
+		var panicErr *DieRollPanicError
+		if r != nil {
+			panicErr = &DieRollPanicError{
+				Name:  "ping.Ping",
+				Stack: string(debug.Stack()),
+				Value: r,
+			}
+			err = panicErr
+		}
+		// a nil response is handed to the hooks as a nil message, not as a typed nil
+		var out proto.Message
+		if resp != nil {
+			out = resp
+		}
+		for i--; i >= 0; i-- {
+			err = s.middlewares[i].AfterActivity(ctx, "ping.Ping", req, out, err)
+		}
+		if panicErr != nil && err == panicErr {
+			panic(panicErr.Value)
+		}
+	}()
+
+	for i < len(s.middlewares) {
+		c, err := s.middlewares[i].BeforeActivity(ctx, "ping.Ping", req)
+		if err != nil {
+			returned = true
+			return nil, err
+		}
+		ctx = c
+		i++
+	}
+
+	resp, err = s.svc.Ping(ctx, req)
+	returned = true
+	return resp, err
+}
+
+// ParentWorkflow runs the ParentWorkflow workflow through the middlewares
+func (s *wrappedDieRollService) ParentWorkflow(ctx workflow.Context, req *emptypb.Empty) (resp *ParentWorkflowReply, err error) {
+	i, returned := 0, false
+	defer func() {
+		r := recover()
+		if r == nil && !returned {
+			// the goroutine exited without returning, nothing to hand to the hooks
+			return
+		}
+		var panicErr *DieRollPanicError
+		if r != nil {
+			panicErr = &DieRollPanicError{
+				Name:  "example.v1.DieRoll.ParentWorkflow",
+				Stack: string(debug.Stack()),
+				Value: r,
+			}
+			err = panicErr
+		}
+		// a nil response is handed to the hooks as a nil message, not as a typed nil
+		var out proto.Message
+		if resp != nil {
+			out = resp
+		}
+		for i--; i >= 0; i-- {
+			err = s.middlewares[i].AfterWorkflow(ctx, "example.v1.DieRoll.ParentWorkflow", req, out, err)
+		}
+		if panicErr != nil && err == panicErr {
+			panic(panicErr.Value)
+		}
+	}()
+
+	for i < len(s.middlewares) {
+		c, err := s.middlewares[i].BeforeWorkflow(ctx, "example.v1.DieRoll.ParentWorkflow", req)
+		if err != nil {
+			returned = true
+			return nil, err
+		}
+		ctx = c
+		i++
+	}
+
+	resp, err = s.svc.ParentWorkflow(ctx, req)
+	returned = true
+	return resp, err
+}
+
+// ChildWorkflow runs the ChildWorkflow workflow through the middlewares
+func (s *wrappedDieRollService) ChildWorkflow(ctx workflow.Context, req *emptypb.Empty) (resp *emptypb.Empty, err error) {
+	i, returned := 0, false
+	defer func() {
+		r := recover()
+		if r == nil && !returned {
+			// the goroutine exited without returning, nothing to hand to the hooks
+			return
+		}
+		var panicErr *DieRollPanicError
+		if r != nil {
+			panicErr = &DieRollPanicError{
+				Name:  "example.v1.DieRoll.ChildWorkflow",
+				Stack: string(debug.Stack()),
+				Value: r,
+			}
+			err = panicErr
+		}
+		// a nil response is handed to the hooks as a nil message, not as a typed nil
+		var out proto.Message
+		if resp != nil {
+			out = resp
+		}
+		for i--; i >= 0; i-- {
+			err = s.middlewares[i].AfterWorkflow(ctx, "example.v1.DieRoll.ChildWorkflow", req, out, err)
+		}
+		if panicErr != nil && err == panicErr {
+			panic(panicErr.Value)
+		}
+	}()
+
+	for i < len(s.middlewares) {
+		c, err := s.middlewares[i].BeforeWorkflow(ctx, "example.v1.DieRoll.ChildWorkflow", req)
+		if err != nil {
+			returned = true
+			return nil, err
+		}
+		ctx = c
+		i++
+	}
+
+	resp, err = s.svc.ChildWorkflow(ctx, req)
+	returned = true
+	return resp, err
+}
+
+// ThrowDies runs the ThrowDies workflow through the middlewares
+func (s *wrappedDieRollService) ThrowDies(ctx workflow.Context, req *ThrowDiesRequest) (resp *ThrowDiesResponse, err error) {
+	i, returned := 0, false
+	defer func() {
+		r := recover()
+		if r == nil && !returned {
+			// the goroutine exited without returning, nothing to hand to the hooks
+			return
+		}
+		var panicErr *DieRollPanicError
+		if r != nil {
+			panicErr = &DieRollPanicError{
+				Name:  "example.v1.DieRoll.ThrowDies",
+				Stack: string(debug.Stack()),
+				Value: r,
+			}
+			err = panicErr
+		}
+		// a nil response is handed to the hooks as a nil message, not as a typed nil
+		var out proto.Message
+		if resp != nil {
+			out = resp
+		}
+		for i--; i >= 0; i-- {
+			err = s.middlewares[i].AfterWorkflow(ctx, "example.v1.DieRoll.ThrowDies", req, out, err)
+		}
+		if panicErr != nil && err == panicErr {
+			panic(panicErr.Value)
+		}
+	}()
+
+	for i < len(s.middlewares) {
+		c, err := s.middlewares[i].BeforeWorkflow(ctx, "example.v1.DieRoll.ThrowDies", req)
+		if err != nil {
+			returned = true
+			return nil, err
+		}
+		ctx = c
+		i++
+	}
+
+	resp, err = s.svc.ThrowDies(ctx, req)
+	returned = true
+	return resp, err
+}
+
+// ThrowUntilValue runs the ThrowUntilValue workflow through the middlewares
+func (s *wrappedDieRollService) ThrowUntilValue(ctx workflow.Context, req *ThrowUntilValueRequest) (resp *emptypb.Empty, err error) {
+	i, returned := 0, false
+	defer func() {
+		r := recover()
+		if r == nil && !returned {
+			// the goroutine exited without returning, nothing to hand to the hooks
+			return
+		}
+		var panicErr *DieRollPanicError
+		if r != nil {
+			panicErr = &DieRollPanicError{
+				Name:  "example.v1.DieRoll.ThrowUntilValue",
+				Stack: string(debug.Stack()),
+				Value: r,
+			}
+			err = panicErr
+		}
+		// a nil response is handed to the hooks as a nil message, not as a typed nil
+		var out proto.Message
+		if resp != nil {
+			out = resp
+		}
+		for i--; i >= 0; i-- {
+			err = s.middlewares[i].AfterWorkflow(ctx, "example.v1.DieRoll.ThrowUntilValue", req, out, err)
+		}
+		if panicErr != nil && err == panicErr {
+			panic(panicErr.Value)
+		}
+	}()
+
+	for i < len(s.middlewares) {
+		c, err := s.middlewares[i].BeforeWorkflow(ctx, "example.v1.DieRoll.ThrowUntilValue", req)
+		if err != nil {
+			returned = true
+			return nil, err
+		}
+		ctx = c
+		i++
+	}
+
+	resp, err = s.svc.ThrowUntilValue(ctx, req)
+	returned = true
+	return resp, err
 }
 
 // DieRollClient: Client for the DieRoll service
 type DieRollClient struct {
-	client    client.Client
-	taskQueue string
+	client        client.Client
+	taskQueue     string
+	interceptors  []DieRollClientInterceptor
+	dialed        bool
+	dataConverter converter.DataConverter
 }
 
 // NewDieRollClient: Returns a new instance of the client.
+// Its calls run through the `interceptors`, which can be nil, the first one being the outermost.
 // If `taskQueue` stays empty the default one will be used
-func NewDieRollClient(client client.Client, taskQueue ...string) (*DieRollClient, error) {
+func NewDieRollClient(client client.Client, interceptors []DieRollClientInterceptor, taskQueue ...string) (*DieRollClient, error) {
 	clientTaskQueue := DefaultDieRollTaskQueueName
 	if len(taskQueue) > 0 {
 		clientTaskQueue = taskQueue[0]
 	}
 	return &DieRollClient{
-		client:    client,
-		taskQueue: clientTaskQueue,
+		client:       client,
+		taskQueue:    clientTaskQueue,
+		interceptors: interceptors,
 	}, nil
 }
 
 // ExecuteActivityThrowDie executes the activity asynchronously and returns a future to it
 func (c *DieRollClient) ExecuteActivityThrowDie(ctx workflow.Context, req *emptypb.Empty, options ...workflow.ActivityOptions) workflow.Future {
+	if err := validateDieRollRequest("example.v1.DieRoll.ThrowDie", req); err != nil {
+		future, settable := workflow.NewFuture(ctx)
+		settable.SetError(err)
+		return future
+	}
 	var aOptions workflow.ActivityOptions
 	if len(options) > 0 {
 		aOptions = options[0]
@@ -194,31 +576,31 @@ func (c *DieRollClient) ExecuteActivityThrowDie(ctx workflow.Context, req *empty
 		aOptions.TaskQueue = DefaultDieRollTaskQueueName
 	}
 	if aOptions.StartToCloseTimeout == 0 {
-		aOptions.StartToCloseTimeout = time.Duration(int32(120)) * time.Second
+		aOptions.StartToCloseTimeout = time.Duration(120) * time.Second
 	}
 	if aOptions.ScheduleToCloseTimeout == 0 {
-		aOptions.ScheduleToCloseTimeout = time.Duration(int32(120)) * time.Second
+		aOptions.ScheduleToCloseTimeout = time.Duration(120) * time.Second
 	}
 	if aOptions.ScheduleToStartTimeout == 0 {
-		aOptions.ScheduleToStartTimeout = time.Duration(int32(30)) * time.Second
+		aOptions.ScheduleToStartTimeout = time.Duration(30) * time.Second
 	}
 	if aOptions.RetryPolicy == nil {
 		aOptions.RetryPolicy = &temporal.RetryPolicy{
-			InitialInterval:        time.Duration(int32(1)) * time.Second,
-			MaximumInterval:        time.Duration(int32(10)) * time.Second,
+			InitialInterval:        time.Duration(1) * time.Second,
+			MaximumInterval:        time.Duration(10) * time.Second,
 			BackoffCoefficient:     float64(float32(1.5)),
 			MaximumAttempts:        int32(10),
-			NonRetryableErrorTypes: []string{"FATAL", "NOT_FOUND"},
+			NonRetryableErrorTypes: []string{"example.v1.DIE_ERROR_LOST", "example.v1.DIE_ERROR_CRACKED"},
 		}
 	}
 	if aOptions.ScheduleToCloseTimeout == 0 {
-		aOptions.ScheduleToCloseTimeout = time.Duration(int32(120)) * time.Second
+		aOptions.ScheduleToCloseTimeout = time.Duration(120) * time.Second
 	}
 	if aOptions.StartToCloseTimeout == 0 {
-		aOptions.StartToCloseTimeout = time.Duration(int32(120)) * time.Second
+		aOptions.StartToCloseTimeout = time.Duration(120) * time.Second
 	}
 	if aOptions.ScheduleToStartTimeout == 0 {
-		aOptions.ScheduleToStartTimeout = time.Duration(int32(30)) * time.Second
+		aOptions.ScheduleToStartTimeout = time.Duration(30) * time.Second
 	}
 	return workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, aOptions), "example.v1.DieRoll.ThrowDie", req)
 }
@@ -242,6 +624,11 @@ func (c *DieRollClient) ExecuteActivityThrowDieSync(ctx workflow.Context, req *e
 
 // ExecuteActivityPing executes the activity asynchronously and returns a future to it
 func (c *DieRollClient) ExecuteActivityPing(ctx workflow.Context, req *emptypb.Empty, options ...workflow.ActivityOptions) workflow.Future {
+	if err := validateDieRollRequest("ping.Ping", req); err != nil {
+		future, settable := workflow.NewFuture(ctx)
+		settable.SetError(err)
+		return future
+	}
 	var aOptions workflow.ActivityOptions
 	if len(options) > 0 {
 		aOptions = options[0]
@@ -256,7 +643,7 @@ func (c *DieRollClient) ExecuteActivityPing(ctx workflow.Context, req *emptypb.E
 		aOptions.ScheduleToCloseTimeout = time.Duration(DefaultDieRollActivityScheduleToCloseTimeout) * time.Second
 	}
 	if aOptions.HeartbeatTimeout == 0 {
-		aOptions.HeartbeatTimeout = time.Duration(int32(60)) * time.Second
+		aOptions.HeartbeatTimeout = time.Duration(60) * time.Second
 	}
 	return workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, aOptions), "ping.Ping", req)
 }
@@ -280,9 +667,24 @@ func (c *DieRollClient) ExecuteActivityPingSync(ctx workflow.Context, req *empty
 
 // ExecuteWorkflowParentWorkflow executes the workflow and returns a future to it
 func (c *DieRollClient) ExecuteWorkflowParentWorkflow(ctx context.Context, req *emptypb.Empty, options ...client.StartWorkflowOptions) (client.WorkflowRun, error) {
-	wOptions := client.StartWorkflowOptions{}
+	opts := client.StartWorkflowOptions{}
 	if len(options) > 0 {
-		wOptions = options[0]
+		opts = options[0]
+	}
+	next := c.executeWorkflowParentWorkflow
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		interceptor, inner := c.interceptors[i], next
+		next = func(ctx context.Context, req *emptypb.Empty, opts client.StartWorkflowOptions) (client.WorkflowRun, error) {
+			return interceptor.InterceptExecuteWorkflowParentWorkflow(ctx, req, opts, inner)
+		}
+	}
+	return next(ctx, req, opts)
+}
+
+// executeWorkflowParentWorkflow executes the workflow once the client interceptors ran
+func (c *DieRollClient) executeWorkflowParentWorkflow(ctx context.Context, req *emptypb.Empty, wOptions client.StartWorkflowOptions) (client.WorkflowRun, error) {
+	if err := validateDieRollRequest("example.v1.DieRoll.ParentWorkflow", req); err != nil {
+		return nil, err
 	}
 	if wOptions.TaskQueue == "" {
 		wOptions.TaskQueue = c.taskQueue
@@ -293,6 +695,12 @@ func (c *DieRollClient) ExecuteWorkflowParentWorkflow(ctx context.Context, req *
 	if wOptions.ID == "" {
 		wOptions.ID = fmt.Sprintf("%s/%s", "example.v1.DieRoll.ParentWorkflow", uuid.NewString())
 	}
+	if wOptions.WorkflowExecutionTimeout == 0 {
+		wOptions.WorkflowExecutionTimeout = time.Duration(86400) * time.Second
+	}
+	if wOptions.WorkflowRunTimeout == 0 {
+		wOptions.WorkflowRunTimeout = time.Duration(7200) * time.Second
+	}
 	return c.client.ExecuteWorkflow(ctx, wOptions, "example.v1.DieRoll.ParentWorkflow", req)
 }
 
@@ -323,6 +731,9 @@ func (c *DieRollClient) GetWorkflowParentWorkflowResult(ctx context.Context, wor
 
 // ExecuteChildParentWorkflow executes the workflow as a child workflow and returns a future to it
 func (c *DieRollClient) ExecuteChildParentWorkflow(ctx workflow.Context, req *emptypb.Empty, options ...workflow.ChildWorkflowOptions) (workflow.ChildWorkflowFuture, error) {
+	if err := validateDieRollRequest("example.v1.DieRoll.ParentWorkflow", req); err != nil {
+		return nil, err
+	}
 	wOptions := workflow.ChildWorkflowOptions{}
 	if len(options) > 0 {
 		wOptions = options[0]
@@ -344,6 +755,12 @@ func (c *DieRollClient) ExecuteChildParentWorkflow(ctx workflow.Context, req *em
 		}
 		wOptions.WorkflowID = id
 	}
+	if wOptions.WorkflowExecutionTimeout == 0 {
+		wOptions.WorkflowExecutionTimeout = time.Duration(86400) * time.Second
+	}
+	if wOptions.WorkflowRunTimeout == 0 {
+		wOptions.WorkflowRunTimeout = time.Duration(7200) * time.Second
+	}
 	return workflow.ExecuteChildWorkflow(workflow.WithChildOptions(ctx, wOptions), "example.v1.DieRoll.ParentWorkflow", req), nil
 }
 
@@ -363,9 +780,24 @@ func (c *DieRollClient) ExecuteChildParentWorkflowSync(ctx workflow.Context, req
 
 // ExecuteWorkflowChildWorkflow executes the workflow and returns a future to it
 func (c *DieRollClient) ExecuteWorkflowChildWorkflow(ctx context.Context, req *emptypb.Empty, options ...client.StartWorkflowOptions) (client.WorkflowRun, error) {
-	wOptions := client.StartWorkflowOptions{}
+	opts := client.StartWorkflowOptions{}
 	if len(options) > 0 {
-		wOptions = options[0]
+		opts = options[0]
+	}
+	next := c.executeWorkflowChildWorkflow
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		interceptor, inner := c.interceptors[i], next
+		next = func(ctx context.Context, req *emptypb.Empty, opts client.StartWorkflowOptions) (client.WorkflowRun, error) {
+			return interceptor.InterceptExecuteWorkflowChildWorkflow(ctx, req, opts, inner)
+		}
+	}
+	return next(ctx, req, opts)
+}
+
+// executeWorkflowChildWorkflow executes the workflow once the client interceptors ran
+func (c *DieRollClient) executeWorkflowChildWorkflow(ctx context.Context, req *emptypb.Empty, wOptions client.StartWorkflowOptions) (client.WorkflowRun, error) {
+	if err := validateDieRollRequest("example.v1.DieRoll.ChildWorkflow", req); err != nil {
+		return nil, err
 	}
 	if wOptions.TaskQueue == "" {
 		wOptions.TaskQueue = c.taskQueue
@@ -377,10 +809,10 @@ func (c *DieRollClient) ExecuteWorkflowChildWorkflow(ctx context.Context, req *e
 		wOptions.ID = fmt.Sprintf("%s/%s", "example.v1.DieRoll.ChildWorkflow", uuid.NewString())
 	}
 	if wOptions.WorkflowExecutionTimeout == 0 {
-		wOptions.WorkflowExecutionTimeout = time.Duration(int32(86400)) * time.Second
+		wOptions.WorkflowExecutionTimeout = time.Duration(86400) * time.Second
 	}
 	if wOptions.WorkflowRunTimeout == 0 {
-		wOptions.WorkflowRunTimeout = time.Duration(int32(7200)) * time.Second
+		wOptions.WorkflowRunTimeout = time.Duration(7200) * time.Second
 	}
 	return c.client.ExecuteWorkflow(ctx, wOptions, "example.v1.DieRoll.ChildWorkflow", req)
 }
@@ -412,6 +844,9 @@ func (c *DieRollClient) GetWorkflowChildWorkflowResult(ctx context.Context, work
 
 // ExecuteChildChildWorkflow executes the workflow as a child workflow and returns a future to it
 func (c *DieRollClient) ExecuteChildChildWorkflow(ctx workflow.Context, req *emptypb.Empty, options ...workflow.ChildWorkflowOptions) (workflow.ChildWorkflowFuture, error) {
+	if err := validateDieRollRequest("example.v1.DieRoll.ChildWorkflow", req); err != nil {
+		return nil, err
+	}
 	wOptions := workflow.ChildWorkflowOptions{}
 	if len(options) > 0 {
 		wOptions = options[0]
@@ -434,10 +869,16 @@ func (c *DieRollClient) ExecuteChildChildWorkflow(ctx workflow.Context, req *emp
 		wOptions.WorkflowID = id
 	}
 	if wOptions.WorkflowExecutionTimeout == 0 {
-		wOptions.WorkflowExecutionTimeout = time.Duration(int32(86400)) * time.Second
+		wOptions.WorkflowExecutionTimeout = time.Duration(86400) * time.Second
 	}
 	if wOptions.WorkflowRunTimeout == 0 {
-		wOptions.WorkflowRunTimeout = time.Duration(int32(7200)) * time.Second
+		wOptions.WorkflowRunTimeout = time.Duration(7200) * time.Second
+	}
+	if wOptions.ParentClosePolicy == v1.PARENT_CLOSE_POLICY_UNSPECIFIED {
+		wOptions.ParentClosePolicy = v1.PARENT_CLOSE_POLICY_REQUEST_CANCEL
+	}
+	if len(options) == 0 {
+		wOptions.WaitForCancellation = true
 	}
 	return workflow.ExecuteChildWorkflow(workflow.WithChildOptions(ctx, wOptions), "example.v1.DieRoll.ChildWorkflow", req), nil
 }
@@ -458,9 +899,24 @@ func (c *DieRollClient) ExecuteChildChildWorkflowSync(ctx workflow.Context, req
 
 // ExecuteWorkflowThrowDies executes the workflow and returns a future to it
 func (c *DieRollClient) ExecuteWorkflowThrowDies(ctx context.Context, req *ThrowDiesRequest, options ...client.StartWorkflowOptions) (client.WorkflowRun, error) {
-	wOptions := client.StartWorkflowOptions{}
+	opts := client.StartWorkflowOptions{}
 	if len(options) > 0 {
-		wOptions = options[0]
+		opts = options[0]
+	}
+	next := c.executeWorkflowThrowDies
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		interceptor, inner := c.interceptors[i], next
+		next = func(ctx context.Context, req *ThrowDiesRequest, opts client.StartWorkflowOptions) (client.WorkflowRun, error) {
+			return interceptor.InterceptExecuteWorkflowThrowDies(ctx, req, opts, inner)
+		}
+	}
+	return next(ctx, req, opts)
+}
+
+// executeWorkflowThrowDies executes the workflow once the client interceptors ran
+func (c *DieRollClient) executeWorkflowThrowDies(ctx context.Context, req *ThrowDiesRequest, wOptions client.StartWorkflowOptions) (client.WorkflowRun, error) {
+	if err := validateDieRollRequest("example.v1.DieRoll.ThrowDies", req); err != nil {
+		return nil, err
 	}
 	if wOptions.TaskQueue == "" {
 		wOptions.TaskQueue = c.taskQueue
@@ -471,6 +927,12 @@ func (c *DieRollClient) ExecuteWorkflowThrowDies(ctx context.Context, req *Throw
 	if wOptions.ID == "" {
 		wOptions.ID = fmt.Sprintf("%s/%s", "example.v1.DieRoll.ThrowDies", uuid.NewString())
 	}
+	if wOptions.WorkflowExecutionTimeout == 0 {
+		wOptions.WorkflowExecutionTimeout = time.Duration(86400) * time.Second
+	}
+	if wOptions.WorkflowRunTimeout == 0 {
+		wOptions.WorkflowRunTimeout = time.Duration(7200) * time.Second
+	}
 	return c.client.ExecuteWorkflow(ctx, wOptions, "example.v1.DieRoll.ThrowDies", req)
 }
 
@@ -501,6 +963,9 @@ func (c *DieRollClient) GetWorkflowThrowDiesResult(ctx context.Context, workflow
 
 // ExecuteChildThrowDies executes the workflow as a child workflow and returns a future to it
 func (c *DieRollClient) ExecuteChildThrowDies(ctx workflow.Context, req *ThrowDiesRequest, options ...workflow.ChildWorkflowOptions) (workflow.ChildWorkflowFuture, error) {
+	if err := validateDieRollRequest("example.v1.DieRoll.ThrowDies", req); err != nil {
+		return nil, err
+	}
 	wOptions := workflow.ChildWorkflowOptions{}
 	if len(options) > 0 {
 		wOptions = options[0]
@@ -522,6 +987,12 @@ func (c *DieRollClient) ExecuteChildThrowDies(ctx workflow.Context, req *ThrowDi
 		}
 		wOptions.WorkflowID = id
 	}
+	if wOptions.WorkflowExecutionTimeout == 0 {
+		wOptions.WorkflowExecutionTimeout = time.Duration(86400) * time.Second
+	}
+	if wOptions.WorkflowRunTimeout == 0 {
+		wOptions.WorkflowRunTimeout = time.Duration(7200) * time.Second
+	}
 	return workflow.ExecuteChildWorkflow(workflow.WithChildOptions(ctx, wOptions), "example.v1.DieRoll.ThrowDies", req), nil
 }
 
@@ -541,9 +1012,24 @@ func (c *DieRollClient) ExecuteChildThrowDiesSync(ctx workflow.Context, req *Thr
 
 // ExecuteWorkflowThrowUntilValue executes the workflow and returns a future to it
 func (c *DieRollClient) ExecuteWorkflowThrowUntilValue(ctx context.Context, req *ThrowUntilValueRequest, options ...client.StartWorkflowOptions) (client.WorkflowRun, error) {
-	wOptions := client.StartWorkflowOptions{}
+	opts := client.StartWorkflowOptions{}
 	if len(options) > 0 {
-		wOptions = options[0]
+		opts = options[0]
+	}
+	next := c.executeWorkflowThrowUntilValue
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		interceptor, inner := c.interceptors[i], next
+		next = func(ctx context.Context, req *ThrowUntilValueRequest, opts client.StartWorkflowOptions) (client.WorkflowRun, error) {
+			return interceptor.InterceptExecuteWorkflowThrowUntilValue(ctx, req, opts, inner)
+		}
+	}
+	return next(ctx, req, opts)
+}
+
+// executeWorkflowThrowUntilValue executes the workflow once the client interceptors ran
+func (c *DieRollClient) executeWorkflowThrowUntilValue(ctx context.Context, req *ThrowUntilValueRequest, wOptions client.StartWorkflowOptions) (client.WorkflowRun, error) {
+	if err := validateDieRollRequest("example.v1.DieRoll.ThrowUntilValue", req); err != nil {
+		return nil, err
 	}
 	if wOptions.TaskQueue == "" {
 		wOptions.TaskQueue = c.taskQueue
@@ -552,7 +1038,16 @@ func (c *DieRollClient) ExecuteWorkflowThrowUntilValue(ctx context.Context, req
 		wOptions.TaskQueue = DefaultDieRollTaskQueueName
 	}
 	if wOptions.ID == "" {
-		wOptions.ID = fmt.Sprintf("%s/%s", "example.v1.DieRoll.ThrowUntilValue", uuid.NewString())
+		wOptions.ID = fmt.Sprintf("throw-until-value/%v", req.GetValue())
+	}
+	if wOptions.WorkflowExecutionTimeout == 0 {
+		wOptions.WorkflowExecutionTimeout = time.Duration(86400) * time.Second
+	}
+	if wOptions.WorkflowRunTimeout == 0 {
+		wOptions.WorkflowRunTimeout = time.Duration(7200) * time.Second
+	}
+	if wOptions.WorkflowIDConflictPolicy == v1.WORKFLOW_ID_CONFLICT_POLICY_UNSPECIFIED {
+		wOptions.WorkflowIDConflictPolicy = v1.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING
 	}
 	return c.client.ExecuteWorkflow(ctx, wOptions, "example.v1.DieRoll.ThrowUntilValue", req)
 }
@@ -584,6 +1079,9 @@ func (c *DieRollClient) GetWorkflowThrowUntilValueResult(ctx context.Context, wo
 
 // ExecuteChildThrowUntilValue executes the workflow as a child workflow and returns a future to it
 func (c *DieRollClient) ExecuteChildThrowUntilValue(ctx workflow.Context, req *ThrowUntilValueRequest, options ...workflow.ChildWorkflowOptions) (workflow.ChildWorkflowFuture, error) {
+	if err := validateDieRollRequest("example.v1.DieRoll.ThrowUntilValue", req); err != nil {
+		return nil, err
+	}
 	wOptions := workflow.ChildWorkflowOptions{}
 	if len(options) > 0 {
 		wOptions = options[0]
@@ -595,15 +1093,13 @@ func (c *DieRollClient) ExecuteChildThrowUntilValue(ctx workflow.Context, req *T
 		wOptions.TaskQueue = DefaultDieRollTaskQueueName
 	}
 	if wOptions.WorkflowID == "" {
-		var id string
-		genId := workflow.SideEffect(ctx, func(ctx workflow.Context) interface{} {
-			return fmt.Sprintf("%s/%s", "example.v1.DieRoll.ThrowUntilValue", uuid.NewString())
-		})
-		err := genId.Get(&id)
-		if err != nil {
-			return nil, err
-		}
-		wOptions.WorkflowID = id
+		wOptions.WorkflowID = fmt.Sprintf("throw-until-value/%v", req.GetValue())
+	}
+	if wOptions.WorkflowExecutionTimeout == 0 {
+		wOptions.WorkflowExecutionTimeout = time.Duration(86400) * time.Second
+	}
+	if wOptions.WorkflowRunTimeout == 0 {
+		wOptions.WorkflowRunTimeout = time.Duration(7200) * time.Second
 	}
 	return workflow.ExecuteChildWorkflow(workflow.WithChildOptions(ctx, wOptions), "example.v1.DieRoll.ThrowUntilValue", req), nil
 }
@@ -622,88 +1118,304 @@ func (c *DieRollClient) ExecuteChildThrowUntilValueSync(ctx workflow.Context, re
 	return resp, nil
 }
 
-// DieRollParentWorkflow is a struct that wraps a workflow
-type DieRollParentWorkflow struct {
-	client     client.Client
-	future     client.WorkflowRun
-	workflowId string
-	runId      string
+// DieRollClientInterceptor intercepts the calls of the DieRollClient, each hook gets the typed
+// request and calls next to run the rest of the chain, up to temporal
+type DieRollClientInterceptor interface {
+	// InterceptExecuteWorkflowParentWorkflow intercepts the call that starts the ParentWorkflow workflow
+	InterceptExecuteWorkflowParentWorkflow(ctx context.Context, req *emptypb.Empty, opts client.StartWorkflowOptions, next func(context.Context, *emptypb.Empty, client.StartWorkflowOptions) (client.WorkflowRun, error)) (client.WorkflowRun, error)
+	// InterceptExecuteWorkflowChildWorkflow intercepts the call that starts the ChildWorkflow workflow
+	InterceptExecuteWorkflowChildWorkflow(ctx context.Context, req *emptypb.Empty, opts client.StartWorkflowOptions, next func(context.Context, *emptypb.Empty, client.StartWorkflowOptions) (client.WorkflowRun, error)) (client.WorkflowRun, error)
+	// InterceptExecuteWorkflowThrowDies intercepts the call that starts the ThrowDies workflow
+	InterceptExecuteWorkflowThrowDies(ctx context.Context, req *ThrowDiesRequest, opts client.StartWorkflowOptions, next func(context.Context, *ThrowDiesRequest, client.StartWorkflowOptions) (client.WorkflowRun, error)) (client.WorkflowRun, error)
+	// InterceptExecuteWorkflowThrowUntilValue intercepts the call that starts the ThrowUntilValue workflow
+	InterceptExecuteWorkflowThrowUntilValue(ctx context.Context, req *ThrowUntilValueRequest, opts client.StartWorkflowOptions, next func(context.Context, *ThrowUntilValueRequest, client.StartWorkflowOptions) (client.WorkflowRun, error)) (client.WorkflowRun, error)
+	// InterceptSendSignalContinue intercepts the call that sends the Continue signal
+	InterceptSendSignalContinue(ctx context.Context, workflowID string, runID string, req *ContinueSignalRequest, next func(context.Context, string, string, *ContinueSignalRequest) error) error
+	// InterceptQueryGetThrowsStatus intercepts the call that sends the GetThrowsStatus query
+	InterceptQueryGetThrowsStatus(ctx context.Context, workflowID string, runID string, req *emptypb.Empty, next func(context.Context, string, string, *emptypb.Empty) (*ThrowStatusResponse, error)) (*ThrowStatusResponse, error)
+	// InterceptUpdateChangeTargetValue intercepts the call that sends the ChangeTargetValue update
+	InterceptUpdateChangeTargetValue(ctx context.Context, workflowID string, runID string, req *ChangeTargetValueRequest, stage client.WorkflowUpdateStage, next func(context.Context, string, string, *ChangeTargetValueRequest, client.WorkflowUpdateStage) (client.WorkflowUpdateHandle, error)) (client.WorkflowUpdateHandle, error)
+	// InterceptSignalWithStartParentWorkflowContinue intercepts the call that sends the Continue signal to the ParentWorkflow workflow, starting it if it is not running
+	InterceptSignalWithStartParentWorkflowContinue(ctx context.Context, sigReq *ContinueSignalRequest, wfReq *emptypb.Empty, opts client.StartWorkflowOptions, next func(context.Context, *ContinueSignalRequest, *emptypb.Empty, client.StartWorkflowOptions) (client.WorkflowRun, error)) (client.WorkflowRun, error)
+	// InterceptSignalWithStartThrowDiesContinue intercepts the call that sends the Continue signal to the ThrowDies workflow, starting it if it is not running
+	InterceptSignalWithStartThrowDiesContinue(ctx context.Context, sigReq *ContinueSignalRequest, wfReq *ThrowDiesRequest, opts client.StartWorkflowOptions, next func(context.Context, *ContinueSignalRequest, *ThrowDiesRequest, client.StartWorkflowOptions) (client.WorkflowRun, error)) (client.WorkflowRun, error)
 }
 
-// GetParentWorkflow gets an instance of a given workflow
-func (c *DieRollClient) GetParentWorkflow(ctx context.Context, workflowId string, runId string) *DieRollParentWorkflow {
-	future := c.client.GetWorkflow(ctx, workflowId, runId)
-	return &DieRollParentWorkflow{
-		client:     c.client,
-		future:     future,
-		workflowId: workflowId,
-		runId:      runId,
-	}
-}
+// DieRollClientInterceptorBase is a DieRollClientInterceptor passing all the calls along,
+// to be embedded by the interceptors only implementing some of the hooks
+type DieRollClientInterceptorBase struct{}
 
-// GetParentWorkflowFromRun gets an instance of a given workflow from a future
-func (c *DieRollClient) GetParentWorkflowFromRun(future client.WorkflowRun) *DieRollParentWorkflow {
-	return &DieRollParentWorkflow{
-		workflowId: future.GetID(),
-		runId:      future.GetRunID(),
-		client:     c.client,
-		future:     future,
-	}
+// InterceptExecuteWorkflowParentWorkflow calls next
+func (DieRollClientInterceptorBase) InterceptExecuteWorkflowParentWorkflow(ctx context.Context, req *emptypb.Empty, opts client.StartWorkflowOptions, next func(context.Context, *emptypb.Empty, client.StartWorkflowOptions) (client.WorkflowRun, error)) (client.WorkflowRun, error) {
+	return next(ctx, req, opts)
 }
 
-// Cancel cancels a given workflow
-func (w *DieRollParentWorkflow) Cancel(ctx context.Context) error {
-	return w.client.CancelWorkflow(ctx, w.workflowId, w.runId)
+// InterceptExecuteWorkflowChildWorkflow calls next
+func (DieRollClientInterceptorBase) InterceptExecuteWorkflowChildWorkflow(ctx context.Context, req *emptypb.Empty, opts client.StartWorkflowOptions, next func(context.Context, *emptypb.Empty, client.StartWorkflowOptions) (client.WorkflowRun, error)) (client.WorkflowRun, error) {
+	return next(ctx, req, opts)
 }
 
-// Returns the workflow ID
-func (w *DieRollParentWorkflow) GetID() string {
-	return w.future.GetID()
+// InterceptExecuteWorkflowThrowDies calls next
+func (DieRollClientInterceptorBase) InterceptExecuteWorkflowThrowDies(ctx context.Context, req *ThrowDiesRequest, opts client.StartWorkflowOptions, next func(context.Context, *ThrowDiesRequest, client.StartWorkflowOptions) (client.WorkflowRun, error)) (client.WorkflowRun, error) {
+	return next(ctx, req, opts)
 }
 
-// Returns the run ID
-func (w *DieRollParentWorkflow) GetRunID() string {
-	return w.future.GetRunID()
+// InterceptExecuteWorkflowThrowUntilValue calls next
+func (DieRollClientInterceptorBase) InterceptExecuteWorkflowThrowUntilValue(ctx context.Context, req *ThrowUntilValueRequest, opts client.StartWorkflowOptions, next func(context.Context, *ThrowUntilValueRequest, client.StartWorkflowOptions) (client.WorkflowRun, error)) (client.WorkflowRun, error) {
+	return next(ctx, req, opts)
 }
 
-// Terminates terminates a given workflow
-func (w *DieRollParentWorkflow) Terminate(ctx context.Context, reason string, details ...interface{}) error {
-	return w.client.TerminateWorkflow(ctx, w.workflowId, w.runId, reason, details...)
+// InterceptSendSignalContinue calls next
+func (DieRollClientInterceptorBase) InterceptSendSignalContinue(ctx context.Context, workflowID string, runID string, req *ContinueSignalRequest, next func(context.Context, string, string, *ContinueSignalRequest) error) error {
+	return next(ctx, workflowID, runID, req)
 }
 
-// Get gets the result of a given workflow with its native type
-func (w *DieRollParentWorkflow) Result(ctx context.Context) (*ParentWorkflowReply, error) {
-	var resp *ParentWorkflowReply
-	err := w.future.Get(ctx, &resp)
-	if err != nil {
-		return nil, err
-	}
-	return resp, nil
+// InterceptQueryGetThrowsStatus calls next
+func (DieRollClientInterceptorBase) InterceptQueryGetThrowsStatus(ctx context.Context, workflowID string, runID string, req *emptypb.Empty, next func(context.Context, string, string, *emptypb.Empty) (*ThrowStatusResponse, error)) (*ThrowStatusResponse, error) {
+	return next(ctx, workflowID, runID, req)
 }
 
-// ResultWithOptions gets the result of a given workflow with its native type
-func (w *DieRollParentWorkflow) ResultWithOptions(ctx context.Context, options client.WorkflowRunGetOptions) (*ParentWorkflowReply, error) {
-	var resp *ParentWorkflowReply
-	err := w.future.GetWithOptions(ctx, &resp, options)
-	if err != nil {
-		return nil, err
-	}
-	return resp, nil
+// InterceptUpdateChangeTargetValue calls next
+func (DieRollClientInterceptorBase) InterceptUpdateChangeTargetValue(ctx context.Context, workflowID string, runID string, req *ChangeTargetValueRequest, stage client.WorkflowUpdateStage, next func(context.Context, string, string, *ChangeTargetValueRequest, client.WorkflowUpdateStage) (client.WorkflowUpdateHandle, error)) (client.WorkflowUpdateHandle, error) {
+	return next(ctx, workflowID, runID, req, stage)
 }
 
-// Get gets the result of a given workflow with pointers -- discouraged to use but required to implement internal.WorkflowRun
-func (w *DieRollParentWorkflow) Get(ctx context.Context, valuePtr interface{}) error {
-	return w.future.Get(ctx, valuePtr)
+// InterceptSignalWithStartParentWorkflowContinue calls next
+func (DieRollClientInterceptorBase) InterceptSignalWithStartParentWorkflowContinue(ctx context.Context, sigReq *ContinueSignalRequest, wfReq *emptypb.Empty, opts client.StartWorkflowOptions, next func(context.Context, *ContinueSignalRequest, *emptypb.Empty, client.StartWorkflowOptions) (client.WorkflowRun, error)) (client.WorkflowRun, error) {
+	return next(ctx, sigReq, wfReq, opts)
 }
 
-// Get gets the result of a given workflow with pointers -- discouraged to use but required to implement internal.WorkflowRun
-func (w *DieRollParentWorkflow) GetWithOptions(ctx context.Context, valuePtr interface{}, options client.WorkflowRunGetOptions) error {
-	return w.future.GetWithOptions(ctx, valuePtr, options)
+// InterceptSignalWithStartThrowDiesContinue calls next
+func (DieRollClientInterceptorBase) InterceptSignalWithStartThrowDiesContinue(ctx context.Context, sigReq *ContinueSignalRequest, wfReq *ThrowDiesRequest, opts client.StartWorkflowOptions, next func(context.Context, *ContinueSignalRequest, *ThrowDiesRequest, client.StartWorkflowOptions) (client.WorkflowRun, error)) (client.WorkflowRun, error) {
+	return next(ctx, sigReq, wfReq, opts)
+}
+
+// DieRollValidationErrorType is the type of the application errors returned by the workers
+// of the DieRoll service when a request fails its validation, they are not retried
+const DieRollValidationErrorType = "example.v1.DieRoll.ValidationError"
+
+// DieRollValidationError is returned when a request of the DieRoll service fails its validation
+type DieRollValidationError struct {
+	// Name is the registered name of the workflow, activity or signal
+	Name string
+	// Err is the error returned by the Validate method of the request
+	Err error
+}
+
+func (e *DieRollValidationError) Error() string {
+	return fmt.Sprintf("invalid request for %s: %v", e.Name, e.Err)
+}
+
+func (e *DieRollValidationError) Unwrap() error {
+	return e.Err
+}
+
+// validateDieRollRequest calls the Validate method of a request if it implements one
+func validateDieRollRequest(name string, req interface{}) error {
+	v, ok := req.(interface {
+		Validate() error
+	})
+	if !ok {
+		return nil
+	}
+
+	if err := v.Validate(); err != nil {
+		return &DieRollValidationError{
+			Err:  err,
+			Name: name,
+		}
+	}
+
+	return nil
+}
+
+// NewDieRollDataConverter returns a data converter encoding the messages of the DieRoll service as binary protobuf,
+// it still decodes the messages encoded with the other protobuf encoding and falls back to JSON for other values.
+// The payloads go through the `codecs`, if any, like with converter.NewCodecDataConverter
+func NewDieRollDataConverter(codecs ...converter.PayloadCodec) converter.DataConverter {
+	dataConverter := converter.NewCompositeDataConverter(
+		converter.NewNilPayloadConverter(),
+		converter.NewByteSlicePayloadConverter(),
+		converter.NewProtoPayloadConverter(),
+		converter.NewProtoJSONPayloadConverter(),
+		converter.NewJSONPayloadConverter(),
+	)
+	if len(codecs) > 0 {
+		return converter.NewCodecDataConverter(dataConverter, codecs...)
+	}
+	return dataConverter
+}
+
+// NewDieRollClientWithProtoConverter dials temporal with the DieRoll data converter and returns a client using it.
+// A data converter set in `opts` is kept, NewDieRollDataConverter(codecs...) adds payload codecs to the one of the service.
+// If `taskQueue` stays empty the default one will be used
+func NewDieRollClientWithProtoConverter(opts client.Options, taskQueue ...string) (*DieRollClient, error) {
+	if opts.DataConverter == nil {
+		opts.DataConverter = NewDieRollDataConverter()
+	}
+	c, err := client.Dial(opts)
+	if err != nil {
+		return nil, err
+	}
+	svcClient, err := NewDieRollClient(c, nil, taskQueue...)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+	svcClient.dialed = true
+	svcClient.dataConverter = opts.DataConverter
+	return svcClient, nil
+}
+
+// Close closes the temporal client of the DieRoll client if NewDieRollClientWithProtoConverter dialed it,
+// the clients given to the other constructors are left to their owner
+func (c *DieRollClient) Close() {
+	if c.dialed {
+		c.client.Close()
+	}
+}
+
+// NewDieRollWorkerWithProtoConverter dials temporal with the DieRoll data converter and returns a worker using it.
+// A data converter set in `opts` is kept, NewDieRollDataConverter(codecs...) adds payload codecs to the one of the service.
+// If `taskQueue` stays empty the default one will be used
+func NewDieRollWorkerWithProtoConverter(opts client.Options, svc DieRollService, taskQueue string, workerOptions ...worker.Options) (*DieRollWorker, error) {
+	if opts.DataConverter == nil {
+		opts.DataConverter = NewDieRollDataConverter()
+	}
+	c, err := client.Dial(opts)
+	if err != nil {
+		return nil, err
+	}
+	w, err := NewDieRollWorker(c, svc, taskQueue, workerOptions...)
+	if err != nil {
+		c.Close()
+		return nil, err
+	}
+	w.dialed = true
+	return w, nil
+}
+
+// Close closes the temporal client of the DieRoll worker if NewDieRollWorkerWithProtoConverter dialed it,
+// once the worker is stopped. The clients given to the other constructors are left to their owner
+func (w *DieRollWorker) Close() {
+	if w.dialed {
+		w.client.Close()
+	}
+}
+
+// DieRollParentWorkflow is a struct that wraps a workflow
+type DieRollParentWorkflow struct {
+	c          *DieRollClient
+	client     client.Client
+	future     client.WorkflowRun
+	workflowId string
+	runId      string
+}
+
+// GetParentWorkflow gets an instance of a given workflow
+func (c *DieRollClient) GetParentWorkflow(ctx context.Context, workflowId string, runId string) *DieRollParentWorkflow {
+	future := c.client.GetWorkflow(ctx, workflowId, runId)
+	return &DieRollParentWorkflow{
+		c:          c,
+		client:     c.client,
+		future:     future,
+		workflowId: workflowId,
+		runId:      runId,
+	}
+}
+
+// GetParentWorkflowFromRun gets an instance of a given workflow from a future
+func (c *DieRollClient) GetParentWorkflowFromRun(future client.WorkflowRun) *DieRollParentWorkflow {
+	return &DieRollParentWorkflow{
+		workflowId: future.GetID(),
+		runId:      future.GetRunID(),
+		c:          c,
+		client:     c.client,
+		future:     future,
+	}
+}
+
+// StartOrAttachParentWorkflow starts the workflow, or attaches to the running execution if the workflow ID is already in use
+// The USE_EXISTING ID conflict policy is used unless another one is set in `options`, which must set the workflow ID
+func (c *DieRollClient) StartOrAttachParentWorkflow(ctx context.Context, req *emptypb.Empty, options ...client.StartWorkflowOptions) (*DieRollParentWorkflow, error) {
+	wOptions := client.StartWorkflowOptions{}
+	if len(options) > 0 {
+		wOptions = options[0]
+	}
+	if wOptions.ID == "" {
+		return nil, errors.New("StartOrAttachParentWorkflow needs a workflow ID to attach to")
+	}
+	if wOptions.WorkflowIDConflictPolicy == v1.WORKFLOW_ID_CONFLICT_POLICY_UNSPECIFIED {
+		wOptions.WorkflowIDConflictPolicy = v1.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING
+	}
+	future, err := c.ExecuteWorkflowParentWorkflow(ctx, req, wOptions)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetParentWorkflowFromRun(future), nil
+}
+
+// Cancel cancels a given workflow
+func (w *DieRollParentWorkflow) Cancel(ctx context.Context) error {
+	return w.client.CancelWorkflow(ctx, w.workflowId, w.runId)
+}
+
+// Returns the workflow ID
+func (w *DieRollParentWorkflow) GetID() string {
+	return w.future.GetID()
+}
+
+// Returns the run ID
+func (w *DieRollParentWorkflow) GetRunID() string {
+	return w.future.GetRunID()
+}
+
+// Terminates terminates a given workflow
+func (w *DieRollParentWorkflow) Terminate(ctx context.Context, reason string, details ...interface{}) error {
+	return w.client.TerminateWorkflow(ctx, w.workflowId, w.runId, reason, details...)
+}
+
+// Get gets the result of a given workflow with its native type
+func (w *DieRollParentWorkflow) Result(ctx context.Context) (*ParentWorkflowReply, error) {
+	var resp *ParentWorkflowReply
+	err := w.future.Get(ctx, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ResultWithOptions gets the result of a given workflow with its native type
+func (w *DieRollParentWorkflow) ResultWithOptions(ctx context.Context, options client.WorkflowRunGetOptions) (*ParentWorkflowReply, error) {
+	var resp *ParentWorkflowReply
+	err := w.future.GetWithOptions(ctx, &resp, options)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Get gets the result of a given workflow with pointers -- discouraged to use but required to implement internal.WorkflowRun
+func (w *DieRollParentWorkflow) Get(ctx context.Context, valuePtr interface{}) error {
+	return w.future.Get(ctx, valuePtr)
+}
+
+// Get gets the result of a given workflow with pointers -- discouraged to use but required to implement internal.WorkflowRun
+func (w *DieRollParentWorkflow) GetWithOptions(ctx context.Context, valuePtr interface{}, options client.WorkflowRunGetOptions) error {
+	return w.future.GetWithOptions(ctx, valuePtr, options)
 }
 
 // SignalContinue sends the Continue signal to the workflow
 func (w *DieRollParentWorkflow) SignalContinue(ctx context.Context, req *ContinueSignalRequest) error {
-	return w.client.SignalWorkflow(ctx, w.future.GetID(), w.future.GetRunID(), "example.v1.DieRoll.Continue", req)
+	c, workflowID, runID := w.c, w.future.GetID(), w.future.GetRunID()
+	next := c.sendSignalContinue
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		interceptor, inner := c.interceptors[i], next
+		next = func(ctx context.Context, workflowID string, runID string, req *ContinueSignalRequest) error {
+			return interceptor.InterceptSendSignalContinue(ctx, workflowID, runID, req, inner)
+		}
+	}
+	return next(ctx, workflowID, runID, req)
 }
 
 // ChildDieRollParentWorkflowExecution is a struct that wraps a workflow execution (called from another workflow)
@@ -740,6 +1452,13 @@ func (w *ChildDieRollParentWorkflowExecution) GetChildWorkflowExecution() (ctx w
 	return w.future
 }
 
+// WaitStarted blocks until the child workflow is started and returns its execution
+func (w *ChildDieRollParentWorkflowExecution) WaitStarted(ctx workflow.Context) (workflow.Execution, error) {
+	var execution workflow.Execution
+	err := w.future.GetChildWorkflowExecution().Get(ctx, &execution)
+	return execution, err
+}
+
 // Wraps the IsReady method from the future
 func (w *ChildDieRollParentWorkflowExecution) IsReady() bool {
 	return w.future.IsReady()
@@ -757,6 +1476,7 @@ func (w *ChildDieRollParentWorkflowExecution) SignalContinue(ctx workflow.Contex
 
 // DieRollChildWorkflow is a struct that wraps a workflow
 type DieRollChildWorkflow struct {
+	c          *DieRollClient
 	client     client.Client
 	future     client.WorkflowRun
 	workflowId string
@@ -767,6 +1487,7 @@ type DieRollChildWorkflow struct {
 func (c *DieRollClient) GetChildWorkflow(ctx context.Context, workflowId string, runId string) *DieRollChildWorkflow {
 	future := c.client.GetWorkflow(ctx, workflowId, runId)
 	return &DieRollChildWorkflow{
+		c:          c,
 		client:     c.client,
 		future:     future,
 		workflowId: workflowId,
@@ -779,11 +1500,32 @@ func (c *DieRollClient) GetChildWorkflowFromRun(future client.WorkflowRun) *DieR
 	return &DieRollChildWorkflow{
 		workflowId: future.GetID(),
 		runId:      future.GetRunID(),
+		c:          c,
 		client:     c.client,
 		future:     future,
 	}
 }
 
+// StartOrAttachChildWorkflow starts the workflow, or attaches to the running execution if the workflow ID is already in use
+// The USE_EXISTING ID conflict policy is used unless another one is set in `options`, which must set the workflow ID
+func (c *DieRollClient) StartOrAttachChildWorkflow(ctx context.Context, req *emptypb.Empty, options ...client.StartWorkflowOptions) (*DieRollChildWorkflow, error) {
+	wOptions := client.StartWorkflowOptions{}
+	if len(options) > 0 {
+		wOptions = options[0]
+	}
+	if wOptions.ID == "" {
+		return nil, errors.New("StartOrAttachChildWorkflow needs a workflow ID to attach to")
+	}
+	if wOptions.WorkflowIDConflictPolicy == v1.WORKFLOW_ID_CONFLICT_POLICY_UNSPECIFIED {
+		wOptions.WorkflowIDConflictPolicy = v1.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING
+	}
+	future, err := c.ExecuteWorkflowChildWorkflow(ctx, req, wOptions)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetChildWorkflowFromRun(future), nil
+}
+
 // Cancel cancels a given workflow
 func (w *DieRollChildWorkflow) Cancel(ctx context.Context) error {
 	return w.client.CancelWorkflow(ctx, w.workflowId, w.runId)
@@ -868,6 +1610,13 @@ func (w *ChildDieRollChildWorkflowExecution) GetChildWorkflowExecution() (ctx wo
 	return w.future
 }
 
+// WaitStarted blocks until the child workflow is started and returns its execution
+func (w *ChildDieRollChildWorkflowExecution) WaitStarted(ctx workflow.Context) (workflow.Execution, error) {
+	var execution workflow.Execution
+	err := w.future.GetChildWorkflowExecution().Get(ctx, &execution)
+	return execution, err
+}
+
 // Wraps the IsReady method from the future
 func (w *ChildDieRollChildWorkflowExecution) IsReady() bool {
 	return w.future.IsReady()
@@ -880,6 +1629,7 @@ func (w *ChildDieRollChildWorkflowExecution) SignalChildWorkflow(ctx workflow.Co
 
 // DieRollThrowDies is a struct that wraps a workflow
 type DieRollThrowDies struct {
+	c          *DieRollClient
 	client     client.Client
 	future     client.WorkflowRun
 	workflowId string
@@ -890,6 +1640,7 @@ type DieRollThrowDies struct {
 func (c *DieRollClient) GetThrowDies(ctx context.Context, workflowId string, runId string) *DieRollThrowDies {
 	future := c.client.GetWorkflow(ctx, workflowId, runId)
 	return &DieRollThrowDies{
+		c:          c,
 		client:     c.client,
 		future:     future,
 		workflowId: workflowId,
@@ -902,11 +1653,32 @@ func (c *DieRollClient) GetThrowDiesFromRun(future client.WorkflowRun) *DieRollT
 	return &DieRollThrowDies{
 		workflowId: future.GetID(),
 		runId:      future.GetRunID(),
+		c:          c,
 		client:     c.client,
 		future:     future,
 	}
 }
 
+// StartOrAttachThrowDies starts the workflow, or attaches to the running execution if the workflow ID is already in use
+// The USE_EXISTING ID conflict policy is used unless another one is set in `options`, which must set the workflow ID
+func (c *DieRollClient) StartOrAttachThrowDies(ctx context.Context, req *ThrowDiesRequest, options ...client.StartWorkflowOptions) (*DieRollThrowDies, error) {
+	wOptions := client.StartWorkflowOptions{}
+	if len(options) > 0 {
+		wOptions = options[0]
+	}
+	if wOptions.ID == "" {
+		return nil, errors.New("StartOrAttachThrowDies needs a workflow ID to attach to")
+	}
+	if wOptions.WorkflowIDConflictPolicy == v1.WORKFLOW_ID_CONFLICT_POLICY_UNSPECIFIED {
+		wOptions.WorkflowIDConflictPolicy = v1.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING
+	}
+	future, err := c.ExecuteWorkflowThrowDies(ctx, req, wOptions)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetThrowDiesFromRun(future), nil
+}
+
 // Cancel cancels a given workflow
 func (w *DieRollThrowDies) Cancel(ctx context.Context) error {
 	return w.client.CancelWorkflow(ctx, w.workflowId, w.runId)
@@ -959,7 +1731,15 @@ func (w *DieRollThrowDies) GetWithOptions(ctx context.Context, valuePtr interfac
 
 // SignalContinue sends the Continue signal to the workflow
 func (w *DieRollThrowDies) SignalContinue(ctx context.Context, req *ContinueSignalRequest) error {
-	return w.client.SignalWorkflow(ctx, w.future.GetID(), w.future.GetRunID(), "example.v1.DieRoll.Continue", req)
+	c, workflowID, runID := w.c, w.future.GetID(), w.future.GetRunID()
+	next := c.sendSignalContinue
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		interceptor, inner := c.interceptors[i], next
+		next = func(ctx context.Context, workflowID string, runID string, req *ContinueSignalRequest) error {
+			return interceptor.InterceptSendSignalContinue(ctx, workflowID, runID, req, inner)
+		}
+	}
+	return next(ctx, workflowID, runID, req)
 }
 
 // ChildDieRollThrowDiesExecution is a struct that wraps a workflow execution (called from another workflow)
@@ -996,6 +1776,13 @@ func (w *ChildDieRollThrowDiesExecution) GetChildWorkflowExecution() (ctx workfl
 	return w.future
 }
 
+// WaitStarted blocks until the child workflow is started and returns its execution
+func (w *ChildDieRollThrowDiesExecution) WaitStarted(ctx workflow.Context) (workflow.Execution, error) {
+	var execution workflow.Execution
+	err := w.future.GetChildWorkflowExecution().Get(ctx, &execution)
+	return execution, err
+}
+
 // Wraps the IsReady method from the future
 func (w *ChildDieRollThrowDiesExecution) IsReady() bool {
 	return w.future.IsReady()
@@ -1013,6 +1800,7 @@ func (w *ChildDieRollThrowDiesExecution) SignalContinue(ctx workflow.Context, re
 
 // DieRollThrowUntilValue is a struct that wraps a workflow
 type DieRollThrowUntilValue struct {
+	c          *DieRollClient
 	client     client.Client
 	future     client.WorkflowRun
 	workflowId string
@@ -1023,6 +1811,7 @@ type DieRollThrowUntilValue struct {
 func (c *DieRollClient) GetThrowUntilValue(ctx context.Context, workflowId string, runId string) *DieRollThrowUntilValue {
 	future := c.client.GetWorkflow(ctx, workflowId, runId)
 	return &DieRollThrowUntilValue{
+		c:          c,
 		client:     c.client,
 		future:     future,
 		workflowId: workflowId,
@@ -1035,11 +1824,29 @@ func (c *DieRollClient) GetThrowUntilValueFromRun(future client.WorkflowRun) *Di
 	return &DieRollThrowUntilValue{
 		workflowId: future.GetID(),
 		runId:      future.GetRunID(),
+		c:          c,
 		client:     c.client,
 		future:     future,
 	}
 }
 
+// StartOrAttachThrowUntilValue starts the workflow, or attaches to the running execution if the workflow ID is already in use
+// The USE_EXISTING ID conflict policy is used unless another one is set in `options`
+func (c *DieRollClient) StartOrAttachThrowUntilValue(ctx context.Context, req *ThrowUntilValueRequest, options ...client.StartWorkflowOptions) (*DieRollThrowUntilValue, error) {
+	wOptions := client.StartWorkflowOptions{}
+	if len(options) > 0 {
+		wOptions = options[0]
+	}
+	if wOptions.WorkflowIDConflictPolicy == v1.WORKFLOW_ID_CONFLICT_POLICY_UNSPECIFIED {
+		wOptions.WorkflowIDConflictPolicy = v1.WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING
+	}
+	future, err := c.ExecuteWorkflowThrowUntilValue(ctx, req, wOptions)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetThrowUntilValueFromRun(future), nil
+}
+
 // Cancel cancels a given workflow
 func (w *DieRollThrowUntilValue) Cancel(ctx context.Context) error {
 	return w.client.CancelWorkflow(ctx, w.workflowId, w.runId)
@@ -1092,18 +1899,58 @@ func (w *DieRollThrowUntilValue) GetWithOptions(ctx context.Context, valuePtr in
 
 // QueryGetThrowsStatus queries the workflow with GetThrowsStatus
 func (w *DieRollThrowUntilValue) QueryGetThrowsStatus(ctx context.Context, req *emptypb.Empty) (*ThrowStatusResponse, error) {
-	future, err := w.client.QueryWorkflow(ctx, w.future.GetID(), w.future.GetRunID(), "example.v1.DieRoll.GetThrowsStatus", req)
+	c, workflowID, runID := w.c, w.future.GetID(), w.future.GetRunID()
+	next := c.queryGetThrowsStatus
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		interceptor, inner := c.interceptors[i], next
+		next = func(ctx context.Context, workflowID string, runID string, req *emptypb.Empty) (*ThrowStatusResponse, error) {
+			return interceptor.InterceptQueryGetThrowsStatus(ctx, workflowID, runID, req, inner)
+		}
+	}
+	return next(ctx, workflowID, runID, req)
+}
+
+// UpdateChangeTargetValue sends the ChangeTargetValue update to the workflow and waits for its result
+func (w *DieRollThrowUntilValue) UpdateChangeTargetValue(ctx context.Context, req *ChangeTargetValueRequest) (*ChangeTargetValueResponse, error) {
+	c, workflowID, runID := w.c, w.future.GetID(), w.future.GetRunID()
+	stage := client.WorkflowUpdateStageCompleted
+	next := c.updateChangeTargetValue
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		interceptor, inner := c.interceptors[i], next
+		next = func(ctx context.Context, workflowID string, runID string, req *ChangeTargetValueRequest, stage client.WorkflowUpdateStage) (client.WorkflowUpdateHandle, error) {
+			return interceptor.InterceptUpdateChangeTargetValue(ctx, workflowID, runID, req, stage, inner)
+		}
+	}
+	handle, err := next(ctx, workflowID, runID, req, stage)
 	if err != nil {
 		return nil, err
 	}
-	var resp *ThrowStatusResponse
-	err = future.Get(&resp)
+	var resp *ChangeTargetValueResponse
+	err = handle.Get(ctx, &resp)
 	if err != nil {
 		return nil, err
 	}
 	return resp, nil
 }
 
+// UpdateChangeTargetValueAsync sends the ChangeTargetValue update to the workflow and returns a handle to it once it has been accepted
+func (w *DieRollThrowUntilValue) UpdateChangeTargetValueAsync(ctx context.Context, req *ChangeTargetValueRequest) (*DieRollChangeTargetValueUpdateHandle, error) {
+	c, workflowID, runID := w.c, w.future.GetID(), w.future.GetRunID()
+	stage := client.WorkflowUpdateStageAccepted
+	next := c.updateChangeTargetValue
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		interceptor, inner := c.interceptors[i], next
+		next = func(ctx context.Context, workflowID string, runID string, req *ChangeTargetValueRequest, stage client.WorkflowUpdateStage) (client.WorkflowUpdateHandle, error) {
+			return interceptor.InterceptUpdateChangeTargetValue(ctx, workflowID, runID, req, stage, inner)
+		}
+	}
+	handle, err := next(ctx, workflowID, runID, req, stage)
+	if err != nil {
+		return nil, err
+	}
+	return &DieRollChangeTargetValueUpdateHandle{handle: handle}, nil
+}
+
 // ChildDieRollThrowUntilValueExecution is a struct that wraps a workflow execution (called from another workflow)
 type ChildDieRollThrowUntilValueExecution struct {
 	client client.Client
@@ -1138,6 +1985,13 @@ func (w *ChildDieRollThrowUntilValueExecution) GetChildWorkflowExecution() (ctx
 	return w.future
 }
 
+// WaitStarted blocks until the child workflow is started and returns its execution
+func (w *ChildDieRollThrowUntilValueExecution) WaitStarted(ctx workflow.Context) (workflow.Execution, error) {
+	var execution workflow.Execution
+	err := w.future.GetChildWorkflowExecution().Get(ctx, &execution)
+	return execution, err
+}
+
 // Wraps the IsReady method from the future
 func (w *ChildDieRollThrowUntilValueExecution) IsReady() bool {
 	return w.future.IsReady()
@@ -1148,40 +2002,873 @@ func (w *ChildDieRollThrowUntilValueExecution) SignalChildWorkflow(ctx workflow.
 	return w.future.SignalChildWorkflow(ctx, sigName, data)
 }
 
-// SendSignalContinue sends the Continue signal to a workflow
-func (c *DieRollClient) SendSignalContinue(ctx context.Context, workflowID string, runID string, req *ContinueSignalRequest) error {
-	return c.client.SignalWorkflow(ctx, workflowID, runID, "example.v1.DieRoll.Continue", req)
+// ExternalDieRollParentWorkflowExecution is a handle to a ParentWorkflow workflow execution, to be used from another workflow
+type ExternalDieRollParentWorkflowExecution struct {
+	workflowId string
+	runId      string
 }
 
-// ReceiveSignalContinue waits for the the Continue signal
-func ReceiveSignalContinue(ctx workflow.Context) (*ContinueSignalRequest, bool) {
-	var result *ContinueSignalRequest
-	ok := workflow.GetSignalChannel(ctx, "example.v1.DieRoll.Continue").Receive(ctx, &result)
-	return result, ok
+// GetExternalParentWorkflow returns a handle to a running ParentWorkflow workflow, an empty `runID` targets the current run
+// This is called within a workflow exclusively
+func GetExternalParentWorkflow(ctx workflow.Context, workflowID string, runID string) *ExternalDieRollParentWorkflowExecution {
+	return &ExternalDieRollParentWorkflowExecution{
+		runId:      runID,
+		workflowId: workflowID,
+	}
 }
 
-// ReceiveSignalContinueAsync recieves the the Continue signal asynchronously. It doesn't wait if there is no signal in the queue
-func ReceiveSignalContinueAsync(ctx workflow.Context) (*ContinueSignalRequest, bool) {
-	var result *ContinueSignalRequest
-	ok := workflow.GetSignalChannel(ctx, "example.v1.DieRoll.Continue").ReceiveAsync(&result)
-	return result, ok
+// WorkflowID returns the ID of the workflow
+func (w *ExternalDieRollParentWorkflowExecution) WorkflowID() string {
+	return w.workflowId
 }
 
-// QueryGetThrowsStatus sends the GetThrowsStatus query to a workflow
-func (c *DieRollClient) QueryGetThrowsStatus(ctx context.Context, workflowID string, runID string, req *emptypb.Empty) (*ThrowStatusResponse, error) {
-	future, err := c.client.QueryWorkflow(ctx, workflowID, runID, "example.v1.DieRoll.GetThrowsStatus", req)
-	if err != nil {
-		return nil, err
+// RunID returns the run ID of the workflow
+func (w *ExternalDieRollParentWorkflowExecution) RunID() string {
+	return w.runId
+}
+
+// Cancel requests the cancellation of the workflow and waits for the request to be delivered
+func (w *ExternalDieRollParentWorkflowExecution) Cancel(ctx workflow.Context) error {
+	return workflow.RequestCancelExternalWorkflow(ctx, w.workflowId, w.runId).Get(ctx, nil)
+}
+
+// SignalContinue sends the Continue signal to the workflow and waits for it to be delivered
+func (w *ExternalDieRollParentWorkflowExecution) SignalContinue(ctx workflow.Context, req *ContinueSignalRequest) error {
+	return workflow.SignalExternalWorkflow(ctx, w.workflowId, w.runId, "example.v1.DieRoll.Continue", req).Get(ctx, nil)
+}
+
+// ExternalDieRollChildWorkflowExecution is a handle to a ChildWorkflow workflow execution, to be used from another workflow
+type ExternalDieRollChildWorkflowExecution struct {
+	workflowId string
+	runId      string
+}
+
+// GetExternalChildWorkflow returns a handle to a running ChildWorkflow workflow, an empty `runID` targets the current run
+// This is called within a workflow exclusively
+func GetExternalChildWorkflow(ctx workflow.Context, workflowID string, runID string) *ExternalDieRollChildWorkflowExecution {
+	return &ExternalDieRollChildWorkflowExecution{
+		runId:      runID,
+		workflowId: workflowID,
 	}
-	var resp *ThrowStatusResponse
-	err = future.Get(&resp)
-	if err != nil {
-		return nil, err
+}
+
+// WorkflowID returns the ID of the workflow
+func (w *ExternalDieRollChildWorkflowExecution) WorkflowID() string {
+	return w.workflowId
+}
+
+// RunID returns the run ID of the workflow
+func (w *ExternalDieRollChildWorkflowExecution) RunID() string {
+	return w.runId
+}
+
+// Cancel requests the cancellation of the workflow and waits for the request to be delivered
+func (w *ExternalDieRollChildWorkflowExecution) Cancel(ctx workflow.Context) error {
+	return workflow.RequestCancelExternalWorkflow(ctx, w.workflowId, w.runId).Get(ctx, nil)
+}
+
+// ExternalDieRollThrowDiesExecution is a handle to a ThrowDies workflow execution, to be used from another workflow
+type ExternalDieRollThrowDiesExecution struct {
+	workflowId string
+	runId      string
+}
+
+// GetExternalThrowDies returns a handle to a running ThrowDies workflow, an empty `runID` targets the current run
+// This is called within a workflow exclusively
+func GetExternalThrowDies(ctx workflow.Context, workflowID string, runID string) *ExternalDieRollThrowDiesExecution {
+	return &ExternalDieRollThrowDiesExecution{
+		runId:      runID,
+		workflowId: workflowID,
 	}
-	return resp, nil
 }
 
-// HandleQueryGetThrowsStatus sets up the GetThrowsStatus query and responds accordingly, returns an error if it failed
-func HandleQueryGetThrowsStatus(ctx workflow.Context, queryFunc func(req *emptypb.Empty) (*ThrowStatusResponse, error)) error {
-	return workflow.SetQueryHandler(ctx, "example.v1.DieRoll.GetThrowsStatus", queryFunc)
+// WorkflowID returns the ID of the workflow
+func (w *ExternalDieRollThrowDiesExecution) WorkflowID() string {
+	return w.workflowId
+}
+
+// RunID returns the run ID of the workflow
+func (w *ExternalDieRollThrowDiesExecution) RunID() string {
+	return w.runId
+}
+
+// Cancel requests the cancellation of the workflow and waits for the request to be delivered
+func (w *ExternalDieRollThrowDiesExecution) Cancel(ctx workflow.Context) error {
+	return workflow.RequestCancelExternalWorkflow(ctx, w.workflowId, w.runId).Get(ctx, nil)
+}
+
+// SignalContinue sends the Continue signal to the workflow and waits for it to be delivered
+func (w *ExternalDieRollThrowDiesExecution) SignalContinue(ctx workflow.Context, req *ContinueSignalRequest) error {
+	return workflow.SignalExternalWorkflow(ctx, w.workflowId, w.runId, "example.v1.DieRoll.Continue", req).Get(ctx, nil)
+}
+
+// ExternalDieRollThrowUntilValueExecution is a handle to a ThrowUntilValue workflow execution, to be used from another workflow
+type ExternalDieRollThrowUntilValueExecution struct {
+	workflowId string
+	runId      string
+}
+
+// GetExternalThrowUntilValue returns a handle to a running ThrowUntilValue workflow, an empty `runID` targets the current run
+// This is called within a workflow exclusively
+func GetExternalThrowUntilValue(ctx workflow.Context, workflowID string, runID string) *ExternalDieRollThrowUntilValueExecution {
+	return &ExternalDieRollThrowUntilValueExecution{
+		runId:      runID,
+		workflowId: workflowID,
+	}
+}
+
+// WorkflowID returns the ID of the workflow
+func (w *ExternalDieRollThrowUntilValueExecution) WorkflowID() string {
+	return w.workflowId
+}
+
+// RunID returns the run ID of the workflow
+func (w *ExternalDieRollThrowUntilValueExecution) RunID() string {
+	return w.runId
+}
+
+// Cancel requests the cancellation of the workflow and waits for the request to be delivered
+func (w *ExternalDieRollThrowUntilValueExecution) Cancel(ctx workflow.Context) error {
+	return workflow.RequestCancelExternalWorkflow(ctx, w.workflowId, w.runId).Get(ctx, nil)
+}
+
+// SendSignalContinue sends the Continue signal to a workflow
+func (c *DieRollClient) SendSignalContinue(ctx context.Context, workflowID string, runID string, req *ContinueSignalRequest) error {
+	next := c.sendSignalContinue
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		interceptor, inner := c.interceptors[i], next
+		next = func(ctx context.Context, workflowID string, runID string, req *ContinueSignalRequest) error {
+			return interceptor.InterceptSendSignalContinue(ctx, workflowID, runID, req, inner)
+		}
+	}
+	return next(ctx, workflowID, runID, req)
+}
+
+// sendSignalContinue sends the signal once the client interceptors ran
+func (c *DieRollClient) sendSignalContinue(ctx context.Context, workflowID string, runID string, req *ContinueSignalRequest) error {
+	if err := validateDieRollRequest("example.v1.DieRoll.Continue", req); err != nil {
+		return err
+	}
+	return c.client.SignalWorkflow(ctx, workflowID, runID, "example.v1.DieRoll.Continue", req)
+}
+
+// ReceiveSignalContinue waits for the the Continue signal
+func ReceiveSignalContinue(ctx workflow.Context) (*ContinueSignalRequest, bool) {
+	var result *ContinueSignalRequest
+	ok := workflow.GetSignalChannel(ctx, "example.v1.DieRoll.Continue").Receive(ctx, &result)
+	return result, ok
+}
+
+// ReceiveSignalContinueAsync recieves the the Continue signal asynchronously. It doesn't wait if there is no signal in the queue
+func ReceiveSignalContinueAsync(ctx workflow.Context) (*ContinueSignalRequest, bool) {
+	var result *ContinueSignalRequest
+	ok := workflow.GetSignalChannel(ctx, "example.v1.DieRoll.Continue").ReceiveAsync(&result)
+	return result, ok
+}
+
+// DieRollContinueSignalChannel is a typed wrapper around the channel of the Continue signal
+type DieRollContinueSignalChannel struct {
+	channel workflow.ReceiveChannel
+}
+
+// SignalContinueChannel returns the channel of the Continue signal
+func SignalContinueChannel(ctx workflow.Context) *DieRollContinueSignalChannel {
+	return &DieRollContinueSignalChannel{channel: workflow.GetSignalChannel(ctx, "example.v1.DieRoll.Continue")}
+}
+
+// Receive blocks until a signal is received, the boolean is false if the channel was closed
+func (c *DieRollContinueSignalChannel) Receive(ctx workflow.Context) (*ContinueSignalRequest, bool) {
+	var result *ContinueSignalRequest
+	more := c.channel.Receive(ctx, &result)
+	return result, more
+}
+
+// ReceiveAsync returns a pending signal without blocking, the boolean is false if there was none
+func (c *DieRollContinueSignalChannel) ReceiveAsync() (*ContinueSignalRequest, bool) {
+	var result *ContinueSignalRequest
+	ok := c.channel.ReceiveAsync(&result)
+	return result, ok
+}
+
+// ReceiveWithTimeout blocks until a signal is received or the timeout expires, the boolean is false if no signal was received
+func (c *DieRollContinueSignalChannel) ReceiveWithTimeout(ctx workflow.Context, timeout time.Duration) (*ContinueSignalRequest, bool) {
+	var result *ContinueSignalRequest
+	ok, _ := c.channel.ReceiveWithTimeout(ctx, timeout, &result)
+	return result, ok
+}
+
+// Len returns the number of signals waiting to be received
+func (c *DieRollContinueSignalChannel) Len() int {
+	return c.channel.Len()
+}
+
+// AddToSelector adds the channel to a selector, `f` is called with the signal when the selector picks it
+func (c *DieRollContinueSignalChannel) AddToSelector(selector workflow.Selector, f func(*ContinueSignalRequest)) workflow.Selector {
+	return selector.AddReceive(c.channel, func(channel workflow.ReceiveChannel, more bool) {
+		var result *ContinueSignalRequest
+		channel.ReceiveAsync(&result)
+		f(result)
+	})
+}
+
+// SignalWithStartParentWorkflowContinue sends the Continue signal to the ParentWorkflow workflow, starting it if it is not running
+// If `workflowID` is empty, the ID is derived the same way as ExecuteWorkflow would
+func (c *DieRollClient) SignalWithStartParentWorkflowContinue(ctx context.Context, workflowID string, sigReq *ContinueSignalRequest, wfReq *emptypb.Empty, options ...client.StartWorkflowOptions) (*DieRollParentWorkflow, error) {
+	opts := client.StartWorkflowOptions{}
+	if len(options) > 0 {
+		opts = options[0]
+	}
+	if workflowID != "" {
+		opts.ID = workflowID
+	}
+	next := c.signalWithStartParentWorkflowContinue
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		interceptor, inner := c.interceptors[i], next
+		next = func(ctx context.Context, sigReq *ContinueSignalRequest, wfReq *emptypb.Empty, opts client.StartWorkflowOptions) (client.WorkflowRun, error) {
+			return interceptor.InterceptSignalWithStartParentWorkflowContinue(ctx, sigReq, wfReq, opts, inner)
+		}
+	}
+	run, err := next(ctx, sigReq, wfReq, opts)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetParentWorkflowFromRun(run), nil
+}
+
+// signalWithStartParentWorkflowContinue sends the signal and starts the workflow once the client interceptors ran
+func (c *DieRollClient) signalWithStartParentWorkflowContinue(ctx context.Context, sigReq *ContinueSignalRequest, wfReq *emptypb.Empty, wOptions client.StartWorkflowOptions) (client.WorkflowRun, error) {
+	if err := validateDieRollRequest("example.v1.DieRoll.Continue", sigReq); err != nil {
+		return nil, err
+	}
+	if err := validateDieRollRequest("example.v1.DieRoll.ParentWorkflow", wfReq); err != nil {
+		return nil, err
+	}
+	if wOptions.TaskQueue == "" {
+		wOptions.TaskQueue = c.taskQueue
+	}
+	if wOptions.TaskQueue == "" {
+		wOptions.TaskQueue = DefaultDieRollTaskQueueName
+	}
+	if wOptions.ID == "" {
+		wOptions.ID = fmt.Sprintf("%s/%s", "example.v1.DieRoll.ParentWorkflow", uuid.NewString())
+	}
+	if wOptions.WorkflowExecutionTimeout == 0 {
+		wOptions.WorkflowExecutionTimeout = time.Duration(86400) * time.Second
+	}
+	if wOptions.WorkflowRunTimeout == 0 {
+		wOptions.WorkflowRunTimeout = time.Duration(7200) * time.Second
+	}
+	return c.client.SignalWithStartWorkflow(ctx, wOptions.ID, "example.v1.DieRoll.Continue", sigReq, wOptions, "example.v1.DieRoll.ParentWorkflow", wfReq)
+}
+
+// SignalWithStartThrowDiesContinue sends the Continue signal to the ThrowDies workflow, starting it if it is not running
+// If `workflowID` is empty, the ID is derived the same way as ExecuteWorkflow would
+func (c *DieRollClient) SignalWithStartThrowDiesContinue(ctx context.Context, workflowID string, sigReq *ContinueSignalRequest, wfReq *ThrowDiesRequest, options ...client.StartWorkflowOptions) (*DieRollThrowDies, error) {
+	opts := client.StartWorkflowOptions{}
+	if len(options) > 0 {
+		opts = options[0]
+	}
+	if workflowID != "" {
+		opts.ID = workflowID
+	}
+	next := c.signalWithStartThrowDiesContinue
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		interceptor, inner := c.interceptors[i], next
+		next = func(ctx context.Context, sigReq *ContinueSignalRequest, wfReq *ThrowDiesRequest, opts client.StartWorkflowOptions) (client.WorkflowRun, error) {
+			return interceptor.InterceptSignalWithStartThrowDiesContinue(ctx, sigReq, wfReq, opts, inner)
+		}
+	}
+	run, err := next(ctx, sigReq, wfReq, opts)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetThrowDiesFromRun(run), nil
+}
+
+// signalWithStartThrowDiesContinue sends the signal and starts the workflow once the client interceptors ran
+func (c *DieRollClient) signalWithStartThrowDiesContinue(ctx context.Context, sigReq *ContinueSignalRequest, wfReq *ThrowDiesRequest, wOptions client.StartWorkflowOptions) (client.WorkflowRun, error) {
+	if err := validateDieRollRequest("example.v1.DieRoll.Continue", sigReq); err != nil {
+		return nil, err
+	}
+	if err := validateDieRollRequest("example.v1.DieRoll.ThrowDies", wfReq); err != nil {
+		return nil, err
+	}
+	if wOptions.TaskQueue == "" {
+		wOptions.TaskQueue = c.taskQueue
+	}
+	if wOptions.TaskQueue == "" {
+		wOptions.TaskQueue = DefaultDieRollTaskQueueName
+	}
+	if wOptions.ID == "" {
+		wOptions.ID = fmt.Sprintf("%s/%s", "example.v1.DieRoll.ThrowDies", uuid.NewString())
+	}
+	if wOptions.WorkflowExecutionTimeout == 0 {
+		wOptions.WorkflowExecutionTimeout = time.Duration(86400) * time.Second
+	}
+	if wOptions.WorkflowRunTimeout == 0 {
+		wOptions.WorkflowRunTimeout = time.Duration(7200) * time.Second
+	}
+	return c.client.SignalWithStartWorkflow(ctx, wOptions.ID, "example.v1.DieRoll.Continue", sigReq, wOptions, "example.v1.DieRoll.ThrowDies", wfReq)
+}
+
+// QueryGetThrowsStatus sends the GetThrowsStatus query to a workflow
+func (c *DieRollClient) QueryGetThrowsStatus(ctx context.Context, workflowID string, runID string, req *emptypb.Empty) (*ThrowStatusResponse, error) {
+	next := c.queryGetThrowsStatus
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		interceptor, inner := c.interceptors[i], next
+		next = func(ctx context.Context, workflowID string, runID string, req *emptypb.Empty) (*ThrowStatusResponse, error) {
+			return interceptor.InterceptQueryGetThrowsStatus(ctx, workflowID, runID, req, inner)
+		}
+	}
+	return next(ctx, workflowID, runID, req)
+}
+
+// queryGetThrowsStatus sends the query once the client interceptors ran
+func (c *DieRollClient) queryGetThrowsStatus(ctx context.Context, workflowID string, runID string, req *emptypb.Empty) (*ThrowStatusResponse, error) {
+	future, err := c.client.QueryWorkflow(ctx, workflowID, runID, "example.v1.DieRoll.GetThrowsStatus", req)
+	if err != nil {
+		return nil, err
+	}
+	var resp *ThrowStatusResponse
+	err = future.Get(&resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// HandleQueryGetThrowsStatus sets up the GetThrowsStatus query and responds accordingly, returns an error if it failed
+func HandleQueryGetThrowsStatus(ctx workflow.Context, queryFunc func(req *emptypb.Empty) (*ThrowStatusResponse, error)) error {
+	return workflow.SetQueryHandler(ctx, "example.v1.DieRoll.GetThrowsStatus", queryFunc)
+}
+
+// DieRollThrowUntilValueQueryHandlers handles all the queries of the ThrowUntilValue workflow
+type DieRollThrowUntilValueQueryHandlers interface {
+	// QueryGetThrowsStatus responds to the GetThrowsStatus query
+	QueryGetThrowsStatus(req *emptypb.Empty) (*ThrowStatusResponse, error)
+}
+
+// RegisterDieRollThrowUntilValueQueryHandlers sets up all the queries of the ThrowUntilValue workflow, returns an error if one of them failed
+func RegisterDieRollThrowUntilValueQueryHandlers(ctx workflow.Context, impl DieRollThrowUntilValueQueryHandlers) error {
+	if err := workflow.SetQueryHandler(ctx, "example.v1.DieRoll.GetThrowsStatus", impl.QueryGetThrowsStatus); err != nil {
+		return fmt.Errorf("could not set up the GetThrowsStatus query: %w", err)
+	}
+	return nil
+}
+
+// DieRollChangeTargetValueUpdateHandle is a struct that wraps the handle of a ChangeTargetValue update
+type DieRollChangeTargetValueUpdateHandle struct {
+	handle client.WorkflowUpdateHandle
+}
+
+// WorkflowID returns the ID of the updated workflow
+func (h *DieRollChangeTargetValueUpdateHandle) WorkflowID() string {
+	return h.handle.WorkflowID()
+}
+
+// RunID returns the run ID of the updated workflow
+func (h *DieRollChangeTargetValueUpdateHandle) RunID() string {
+	return h.handle.RunID()
+}
+
+// UpdateID returns the ID of the update
+func (h *DieRollChangeTargetValueUpdateHandle) UpdateID() string {
+	return h.handle.UpdateID()
+}
+
+// Result blocks until the update completes and returns its result with its native type
+func (h *DieRollChangeTargetValueUpdateHandle) Result(ctx context.Context) (*ChangeTargetValueResponse, error) {
+	var resp *ChangeTargetValueResponse
+	err := h.handle.Get(ctx, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Get gets the result of the update with pointers -- discouraged to use but required to implement client.WorkflowUpdateHandle
+func (h *DieRollChangeTargetValueUpdateHandle) Get(ctx context.Context, valuePtr interface{}) error {
+	return h.handle.Get(ctx, valuePtr)
+}
+
+// UpdateChangeTargetValue sends the ChangeTargetValue update to a workflow and waits for its result
+func (c *DieRollClient) UpdateChangeTargetValue(ctx context.Context, workflowID string, runID string, req *ChangeTargetValueRequest) (*ChangeTargetValueResponse, error) {
+	stage := client.WorkflowUpdateStageCompleted
+	next := c.updateChangeTargetValue
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		interceptor, inner := c.interceptors[i], next
+		next = func(ctx context.Context, workflowID string, runID string, req *ChangeTargetValueRequest, stage client.WorkflowUpdateStage) (client.WorkflowUpdateHandle, error) {
+			return interceptor.InterceptUpdateChangeTargetValue(ctx, workflowID, runID, req, stage, inner)
+		}
+	}
+	handle, err := next(ctx, workflowID, runID, req, stage)
+	if err != nil {
+		return nil, err
+	}
+	var resp *ChangeTargetValueResponse
+	err = handle.Get(ctx, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// UpdateChangeTargetValueAsync sends the ChangeTargetValue update to a workflow and returns a handle to it once it has been accepted
+func (c *DieRollClient) UpdateChangeTargetValueAsync(ctx context.Context, workflowID string, runID string, req *ChangeTargetValueRequest) (*DieRollChangeTargetValueUpdateHandle, error) {
+	stage := client.WorkflowUpdateStageAccepted
+	next := c.updateChangeTargetValue
+	for i := len(c.interceptors) - 1; i >= 0; i-- {
+		interceptor, inner := c.interceptors[i], next
+		next = func(ctx context.Context, workflowID string, runID string, req *ChangeTargetValueRequest, stage client.WorkflowUpdateStage) (client.WorkflowUpdateHandle, error) {
+			return interceptor.InterceptUpdateChangeTargetValue(ctx, workflowID, runID, req, stage, inner)
+		}
+	}
+	handle, err := next(ctx, workflowID, runID, req, stage)
+	if err != nil {
+		return nil, err
+	}
+	return &DieRollChangeTargetValueUpdateHandle{handle: handle}, nil
+}
+
+// updateChangeTargetValue sends the update once the client interceptors ran
+func (c *DieRollClient) updateChangeTargetValue(ctx context.Context, workflowID string, runID string, req *ChangeTargetValueRequest, stage client.WorkflowUpdateStage) (client.WorkflowUpdateHandle, error) {
+	if err := validateDieRollRequest("example.v1.DieRoll.ChangeTargetValue", req); err != nil {
+		return nil, err
+	}
+	return c.client.UpdateWorkflow(ctx, client.UpdateWorkflowOptions{
+		Args:         []interface{}{req},
+		RunID:        runID,
+		UpdateName:   "example.v1.DieRoll.ChangeTargetValue",
+		WaitForStage: stage,
+		WorkflowID:   workflowID,
+	})
+}
+
+// HandleUpdateChangeTargetValue sets up the ChangeTargetValue update handler, returns an error if it failed
+// The validator is optional and can be left nil, it must not alter the workflow state
+func HandleUpdateChangeTargetValue(ctx workflow.Context, updateFunc func(ctx workflow.Context, req *ChangeTargetValueRequest) (*ChangeTargetValueResponse, error), validatorFunc func(ctx workflow.Context, req *ChangeTargetValueRequest) error) error {
+	opts := workflow.UpdateHandlerOptions{}
+	if validatorFunc != nil {
+		opts.Validator = validatorFunc
+	}
+	return workflow.SetUpdateHandlerWithOptions(ctx, "example.v1.DieRoll.ChangeTargetValue", updateFunc, opts)
+}
+
+// DieRollThrowUntilValueSchedule is a typed handle to a schedule of the ThrowUntilValue workflow
+type DieRollThrowUntilValueSchedule struct {
+	handle client.ScheduleHandle
+}
+
+// GetID returns the ID of the schedule
+func (s *DieRollThrowUntilValueSchedule) GetID() string {
+	return s.handle.GetID()
+}
+
+// Handle returns the underlying client.ScheduleHandle
+func (s *DieRollThrowUntilValueSchedule) Handle() client.ScheduleHandle {
+	return s.handle
+}
+
+// Describe fetches the current state of the schedule
+func (s *DieRollThrowUntilValueSchedule) Describe(ctx context.Context) (*client.ScheduleDescription, error) {
+	return s.handle.Describe(ctx)
+}
+
+// Pause pauses the schedule, no workflow will be started until it is unpaused
+func (s *DieRollThrowUntilValueSchedule) Pause(ctx context.Context, options ...client.SchedulePauseOptions) error {
+	sOptions := client.SchedulePauseOptions{}
+	if len(options) > 0 {
+		sOptions = options[0]
+	}
+	return s.handle.Pause(ctx, sOptions)
+}
+
+// Unpause resumes the schedule
+func (s *DieRollThrowUntilValueSchedule) Unpause(ctx context.Context, options ...client.ScheduleUnpauseOptions) error {
+	sOptions := client.ScheduleUnpauseOptions{}
+	if len(options) > 0 {
+		sOptions = options[0]
+	}
+	return s.handle.Unpause(ctx, sOptions)
+}
+
+// Trigger starts the workflow immediately
+func (s *DieRollThrowUntilValueSchedule) Trigger(ctx context.Context, options ...client.ScheduleTriggerOptions) error {
+	sOptions := client.ScheduleTriggerOptions{}
+	if len(options) > 0 {
+		sOptions = options[0]
+	}
+	return s.handle.Trigger(ctx, sOptions)
+}
+
+// Update replaces the request the ThrowUntilValue workflow is started with
+func (s *DieRollThrowUntilValueSchedule) Update(ctx context.Context, req *ThrowUntilValueRequest) error {
+	return s.handle.Update(ctx, client.ScheduleUpdateOptions{DoUpdate: func(input client.ScheduleUpdateInput) (*client.ScheduleUpdate, error) {
+		action, ok := input.Description.Schedule.Action.(*client.ScheduleWorkflowAction)
+		if !ok {
+			return nil, fmt.Errorf("schedule %s does not start a workflow", s.handle.GetID())
+		}
+		action.Args = []interface{}{req}
+		return &client.ScheduleUpdate{Schedule: &input.Description.Schedule}, nil
+	}})
+}
+
+// Delete deletes the schedule, running workflows are not affected
+func (s *DieRollThrowUntilValueSchedule) Delete(ctx context.Context) error {
+	return s.handle.Delete(ctx)
+}
+
+// CreateScheduleThrowUntilValue creates a schedule starting the ThrowUntilValue workflow with the given request
+// The spec and policies defined in the proto are used unless they are set in `options`
+func (c *DieRollClient) CreateScheduleThrowUntilValue(ctx context.Context, id string, req *ThrowUntilValueRequest, options ...client.ScheduleOptions) (*DieRollThrowUntilValueSchedule, error) {
+	if err := validateDieRollRequest("example.v1.DieRoll.ThrowUntilValue", req); err != nil {
+		return nil, err
+	}
+	sOptions := client.ScheduleOptions{}
+	if len(options) > 0 {
+		sOptions = options[0]
+	}
+	sOptions.ID = id
+	if len(sOptions.Spec.CronExpressions) == 0 && len(sOptions.Spec.Intervals) == 0 && len(sOptions.Spec.Calendars) == 0 {
+		sOptions.Spec.Intervals = []client.ScheduleIntervalSpec{{Every: time.Duration(3600) * time.Second}}
+	}
+	if sOptions.Spec.Jitter == 0 {
+		sOptions.Spec.Jitter = time.Duration(60) * time.Second
+	}
+	if sOptions.Overlap == v1.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED {
+		sOptions.Overlap = v1.SCHEDULE_OVERLAP_POLICY_SKIP
+	}
+	action, ok := sOptions.Action.(*client.ScheduleWorkflowAction)
+	if !ok || action == nil {
+		action = &client.ScheduleWorkflowAction{}
+	}
+	action.Workflow = "example.v1.DieRoll.ThrowUntilValue"
+	action.Args = []interface{}{req}
+	if action.TaskQueue == "" {
+		action.TaskQueue = c.taskQueue
+	}
+	if action.ID == "" {
+		action.ID = fmt.Sprintf("%s/%s", "example.v1.DieRoll.ThrowUntilValue", id)
+	}
+	if action.WorkflowExecutionTimeout == 0 {
+		action.WorkflowExecutionTimeout = time.Duration(86400) * time.Second
+	}
+	if action.WorkflowRunTimeout == 0 {
+		action.WorkflowRunTimeout = time.Duration(7200) * time.Second
+	}
+	sOptions.Action = action
+	handle, err := c.client.ScheduleClient().Create(ctx, sOptions)
+	if err != nil {
+		return nil, err
+	}
+	return &DieRollThrowUntilValueSchedule{handle: handle}, nil
+}
+
+// GetScheduleThrowUntilValue returns a handle to an existing schedule of the ThrowUntilValue workflow
+func (c *DieRollClient) GetScheduleThrowUntilValue(ctx context.Context, id string) *DieRollThrowUntilValueSchedule {
+	return &DieRollThrowUntilValueSchedule{handle: c.client.ScheduleClient().GetHandle(ctx, id)}
+}
+
+// NewParentWorkflowContinueAsNewError returns the error continuing the ParentWorkflow workflow as new with the given request
+func NewParentWorkflowContinueAsNewError(ctx workflow.Context, req *emptypb.Empty, options ...workflow.ContinueAsNewErrorOptions) error {
+	var cOptions workflow.ContinueAsNewErrorOptions
+	if len(options) > 0 {
+		cOptions = options[0]
+	}
+	return workflow.NewContinueAsNewErrorWithOptions(ctx, cOptions, "example.v1.DieRoll.ParentWorkflow", req)
+}
+
+// ParentWorkflowContinueAsNewSuggested returns true when the current run of the ParentWorkflow workflow should continue as new,
+// either because the server suggests it or because its history reached the configured thresholds
+func ParentWorkflowContinueAsNewSuggested(ctx workflow.Context) bool {
+	info := workflow.GetInfo(ctx)
+	if info.GetContinueAsNewSuggested() {
+		return true
+	}
+	return false
+}
+
+// NewChildWorkflowContinueAsNewError returns the error continuing the ChildWorkflow workflow as new with the given request
+func NewChildWorkflowContinueAsNewError(ctx workflow.Context, req *emptypb.Empty, options ...workflow.ContinueAsNewErrorOptions) error {
+	var cOptions workflow.ContinueAsNewErrorOptions
+	if len(options) > 0 {
+		cOptions = options[0]
+	}
+	return workflow.NewContinueAsNewErrorWithOptions(ctx, cOptions, "example.v1.DieRoll.ChildWorkflow", req)
+}
+
+// ChildWorkflowContinueAsNewSuggested returns true when the current run of the ChildWorkflow workflow should continue as new,
+// either because the server suggests it or because its history reached the configured thresholds
+func ChildWorkflowContinueAsNewSuggested(ctx workflow.Context) bool {
+	info := workflow.GetInfo(ctx)
+	if info.GetContinueAsNewSuggested() {
+		return true
+	}
+	return false
+}
+
+// NewThrowDiesContinueAsNewError returns the error continuing the ThrowDies workflow as new with the given request
+func NewThrowDiesContinueAsNewError(ctx workflow.Context, req *ThrowDiesRequest, options ...workflow.ContinueAsNewErrorOptions) error {
+	var cOptions workflow.ContinueAsNewErrorOptions
+	if len(options) > 0 {
+		cOptions = options[0]
+	}
+	return workflow.NewContinueAsNewErrorWithOptions(ctx, cOptions, "example.v1.DieRoll.ThrowDies", req)
+}
+
+// ThrowDiesContinueAsNewSuggested returns true when the current run of the ThrowDies workflow should continue as new,
+// either because the server suggests it or because its history reached the configured thresholds
+func ThrowDiesContinueAsNewSuggested(ctx workflow.Context) bool {
+	info := workflow.GetInfo(ctx)
+	if info.GetContinueAsNewSuggested() {
+		return true
+	}
+	return false
+}
+
+// NewThrowUntilValueContinueAsNewError returns the error continuing the ThrowUntilValue workflow as new with the given request
+func NewThrowUntilValueContinueAsNewError(ctx workflow.Context, req *ThrowUntilValueRequest, options ...workflow.ContinueAsNewErrorOptions) error {
+	var cOptions workflow.ContinueAsNewErrorOptions
+	if len(options) > 0 {
+		cOptions = options[0]
+	}
+	return workflow.NewContinueAsNewErrorWithOptions(ctx, cOptions, "example.v1.DieRoll.ThrowUntilValue", req)
+}
+
+// ThrowUntilValueContinueAsNewSuggested returns true when the current run of the ThrowUntilValue workflow should continue as new,
+// either because the server suggests it or because its history reached the configured thresholds
+func ThrowUntilValueContinueAsNewSuggested(ctx workflow.Context) bool {
+	info := workflow.GetInfo(ctx)
+	if info.GetContinueAsNewSuggested() {
+		return true
+	}
+	if info.GetCurrentHistoryLength() >= 1000 {
+		return true
+	}
+	return false
+}
+
+// InvalidTargetValueErrorType is the type of the application errors carrying InvalidTargetValue details
+const InvalidTargetValueErrorType = "example.v1.InvalidTargetValue"
+
+// NewInvalidTargetValueError returns an application error carrying InvalidTargetValue details
+// It can be returned by activities and workflows, a non retryable error is not retried regardless of the retry policy
+func NewInvalidTargetValueError(msg string, details *InvalidTargetValue, nonRetryable bool) error {
+	return temporal.NewApplicationErrorWithOptions(msg, InvalidTargetValueErrorType, temporal.ApplicationErrorOptions{
+		Details:      []interface{}{details},
+		NonRetryable: nonRetryable,
+	})
+}
+
+// AsInvalidTargetValueError returns the details of the InvalidTargetValue application error found in the chain of `err`,
+// looking through the activity, child workflow and workflow execution errors wrapping it
+func AsInvalidTargetValueError(err error) (*InvalidTargetValue, bool) {
+	for err != nil {
+		var appErr *temporal.ApplicationError
+		if !errors.As(err, &appErr) {
+			return nil, false
+		}
+		if appErr.Type() == InvalidTargetValueErrorType {
+			var details *InvalidTargetValue
+			if appErr.HasDetails() {
+				if err := appErr.Details(&details); err != nil {
+					return nil, false
+				}
+			}
+			return details, true
+		}
+		err = appErr.Unwrap()
+	}
+	return nil, false
+}
+
+// DieErrorLostErrorType is the type of the DIE_ERROR_LOST errors
+const DieErrorLostErrorType = "example.v1.DIE_ERROR_LOST"
+
+// NewDieErrorLostError returns an application error of the DIE_ERROR_LOST type
+// It is not retried by the retry policies using DieError as non retryable error enum
+func NewDieErrorLostError(msg string, details ...interface{}) error {
+	return temporal.NewApplicationError(msg, DieErrorLostErrorType, details...)
+}
+
+// DieErrorCrackedErrorType is the type of the DIE_ERROR_CRACKED errors
+const DieErrorCrackedErrorType = "example.v1.DIE_ERROR_CRACKED"
+
+// NewDieErrorCrackedError returns an application error of the DIE_ERROR_CRACKED type
+// It is not retried by the retry policies using DieError as non retryable error enum
+func NewDieErrorCrackedError(msg string, details ...interface{}) error {
+	return temporal.NewApplicationError(msg, DieErrorCrackedErrorType, details...)
+}
+
+// DieErrorRolledOffTableErrorType is the type of the DIE_ERROR_ROLLED_OFF_TABLE errors
+const DieErrorRolledOffTableErrorType = "example.v1.DIE_ERROR_ROLLED_OFF_TABLE"
+
+// NewDieErrorRolledOffTableError returns an application error of the DIE_ERROR_ROLLED_OFF_TABLE type
+// It is retried by the retry policies using DieError as non retryable error enum
+func NewDieErrorRolledOffTableError(msg string, details ...interface{}) error {
+	return temporal.NewApplicationError(msg, DieErrorRolledOffTableErrorType, details...)
+}
+
+const (
+	// ExampleEncryptedEncoding is the encoding of the payloads encrypted by the ExamplePayloadCodec
+	ExampleEncryptedEncoding = "binary/encrypted"
+	// ExampleEncryptionKeyIDMetadata is the metadata holding the id of the key a payload is encrypted with
+	ExampleEncryptionKeyIDMetadata = "encryption-key-id"
+)
+
+// ExampleSensitiveMessageTypes are the types of the messages containing sensitive fields,
+// their payloads are encrypted by the ExamplePayloadCodec
+var ExampleSensitiveMessageTypes = map[string]bool{"example.v1.ThrowDiesRequest": true}
+
+// ExampleKeyProvider provides the AES keys of the ExamplePayloadCodec, a key must be
+// 16, 24 or 32 bytes long to select AES-128, AES-192 or AES-256
+type ExampleKeyProvider interface {
+	// EncryptionKey returns the key new payloads are encrypted with and its id
+	EncryptionKey() (string, []byte, error)
+	// DecryptionKey returns the key of the given id
+	DecryptionKey(id string) ([]byte, error)
+}
+
+// ExampleStaticKeyProvider is a ExampleKeyProvider using a single key
+type ExampleStaticKeyProvider struct {
+	ID  string
+	Key []byte
+}
+
+// EncryptionKey returns the key of the provider
+func (p ExampleStaticKeyProvider) EncryptionKey() (string, []byte, error) {
+	return p.ID, p.Key, nil
+}
+
+// DecryptionKey returns the key of the provider if its id matches
+func (p ExampleStaticKeyProvider) DecryptionKey(id string) ([]byte, error) {
+	if id != p.ID {
+		return nil, fmt.Errorf("unknown encryption key %q", id)
+	}
+	return p.Key, nil
+}
+
+// ExamplePayloadCodec is a payload codec encrypting with AES-GCM the payloads of the messages
+// listed in ExampleSensitiveMessageTypes, the other payloads are left as they are
+type ExamplePayloadCodec struct {
+	keys ExampleKeyProvider
+}
+
+// NewExamplePayloadCodec returns a payload codec encrypting the sensitive payloads with the keys of the provider
+func NewExamplePayloadCodec(keys ExampleKeyProvider) *ExamplePayloadCodec {
+	return &ExamplePayloadCodec{keys: keys}
+}
+
+func newExampleAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// Encode encrypts the payloads of the messages containing sensitive fields
+func (c *ExamplePayloadCodec) Encode(payloads []*v11.Payload) ([]*v11.Payload, error) {
+	result := make([]*v11.Payload, len(payloads))
+	for i, p := range payloads {
+		if !ExampleSensitiveMessageTypes[string(p.GetMetadata()[converter.MetadataMessageType])] {
+			result[i] = p
+			continue
+		}
+
+		id, key, err := c.keys.EncryptionKey()
+		if err != nil {
+			return nil, fmt.Errorf("could not get the encryption key: %w", err)
+		}
+
+		aead, err := newExampleAEAD(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid encryption key %q: %w", id, err)
+		}
+
+		data, err := proto.Marshal(p)
+		if err != nil {
+			return nil, err
+		}
+
+		nonce := make([]byte, aead.NonceSize())
+		if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+			return nil, err
+		}
+
+		result[i] = &v11.Payload{
+			Data: aead.Seal(nonce, nonce, data, nil),
+			Metadata: map[string][]byte{
+				ExampleEncryptionKeyIDMetadata: []byte(id),
+				converter.MetadataEncoding:     []byte(ExampleEncryptedEncoding),
+			},
+		}
+	}
+
+	return result, nil
+}
+
+// Decode decrypts the payloads encrypted by Encode
+func (c *ExamplePayloadCodec) Decode(payloads []*v11.Payload) ([]*v11.Payload, error) {
+	result := make([]*v11.Payload, len(payloads))
+	for i, p := range payloads {
+		if string(p.GetMetadata()[converter.MetadataEncoding]) != ExampleEncryptedEncoding {
+			result[i] = p
+			continue
+		}
+
+		id := string(p.GetMetadata()[ExampleEncryptionKeyIDMetadata])
+		key, err := c.keys.DecryptionKey(id)
+		if err != nil {
+			return nil, fmt.Errorf("could not get the decryption key %q: %w", id, err)
+		}
+
+		aead, err := newExampleAEAD(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid decryption key %q: %w", id, err)
+		}
+
+		if len(p.GetData()) < aead.NonceSize() {
+			return nil, errors.New("encrypted payload too short")
+		}
+
+		nonce, ciphertext := p.GetData()[:aead.NonceSize()], p.GetData()[aead.NonceSize():]
+		data, err := aead.Open(nil, nonce, ciphertext, nil)
+		if err != nil {
+			return nil, fmt.Errorf("could not decrypt payload: %w", err)
+		}
+
+		decoded := &v11.Payload{}
+		if err := proto.Unmarshal(data, decoded); err != nil {
+			return nil, err
+		}
+		result[i] = decoded
+	}
+
+	return result, nil
+}
+
+// NewExampleCodecHandler returns the handler of a codec server decoding the payloads
+// encrypted by the ExamplePayloadCodec, to be set as the codec endpoint of the Temporal UI.
+// `origins` are the origins of the Temporal UI allowed to call it from a browser
+func NewExampleCodecHandler(keys ExampleKeyProvider, origins ...string) http.Handler {
+	handler := converter.NewPayloadCodecHTTPHandler(NewExamplePayloadCodec(keys))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, origin := range origins {
+			if r.Header.Get("Origin") == origin {
+				w.Header().Set("Access-Control-Allow-Origin", origin)
+				w.Header().Set("Access-Control-Allow-Credentials", "true")
+				w.Header().Set("Access-Control-Allow-Headers", "Content-Type,X-Namespace,Authorization")
+			}
+		}
+
+		if r.Method == http.MethodOptions {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		handler.ServeHTTP(w, r)
+	})
 }
@@ -0,0 +1,377 @@
+// Code generated by protoc-gen-go-tmprl. DO NOT EDIT.
+//
+// version:
+//   protoc-gen-go-tmprl version: master
+//   protoc-gen-go-tmprl commit: master
+//
+// source file: example/v1/example.proto
+
+package examplev1test
+
+import (
+	fmt "fmt"
+	mock "github.com/stretchr/testify/mock"
+	v1 "github.com/thomas-maurice/protoc-gen-go-tmprl/gen/example/v1"
+	testsuite "go.temporal.io/sdk/testsuite"
+	proto "google.golang.org/protobuf/proto"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	testing "testing"
+	time "time"
+)
+
+// DieRollTestEnv wraps a testsuite.TestWorkflowEnvironment with typed helpers for the DieRoll service
+type DieRollTestEnv struct {
+	suite testsuite.WorkflowTestSuite
+	env   *testsuite.TestWorkflowEnvironment
+}
+
+// NewDieRollTestEnv returns a test environment with all the workflows and activities of the service registered
+// by RegisterDieRollService, as a worker registers them.
+// The expectations of the mocks are asserted when the test finishes
+func NewDieRollTestEnv(t testing.TB, svc v1.DieRollService) *DieRollTestEnv {
+	e := &DieRollTestEnv{}
+	e.env = e.suite.NewTestWorkflowEnvironment()
+	v1.RegisterDieRollService(e.env, svc)
+	t.Cleanup(func() {
+		e.env.AssertExpectations(t)
+	})
+	return e
+}
+
+// Env returns the underlying test environment
+func (e *DieRollTestEnv) Env() *testsuite.TestWorkflowEnvironment {
+	return e.env
+}
+
+// DieRollThrowDieMockCall is a typed mock of ThrowDie
+type DieRollThrowDieMockCall struct {
+	call *testsuite.MockCallWrapper
+}
+
+// Return sets the values returned by the mock
+func (m *DieRollThrowDieMockCall) Return(resp *v1.ThrowDieResponse, err error) *DieRollThrowDieMockCall {
+	m.call.Return(resp, err)
+	return m
+}
+
+// Once indicates that the mock should only return the value once
+func (m *DieRollThrowDieMockCall) Once() *DieRollThrowDieMockCall {
+	m.call.Once()
+	return m
+}
+
+// Times indicates that the mock should only return the indicated number of times
+func (m *DieRollThrowDieMockCall) Times(i int) *DieRollThrowDieMockCall {
+	m.call.Times(i)
+	return m
+}
+
+// Call returns the underlying mock call, for the settings that are not wrapped
+func (m *DieRollThrowDieMockCall) Call() *testsuite.MockCallWrapper {
+	return m.call
+}
+
+// OnActivityThrowDie mocks the ThrowDie activity, a nil request matches any request
+func (e *DieRollTestEnv) OnActivityThrowDie(req *emptypb.Empty) *DieRollThrowDieMockCall {
+	var reqMatcher interface{} = mock.Anything
+	if req != nil {
+		reqMatcher = mock.MatchedBy(func(r *emptypb.Empty) bool {
+			return proto.Equal(r, req)
+		})
+	}
+	return &DieRollThrowDieMockCall{call: e.env.OnActivity("example.v1.DieRoll.ThrowDie", mock.Anything, reqMatcher)}
+}
+
+// DieRollPingMockCall is a typed mock of Ping
+type DieRollPingMockCall struct {
+	call *testsuite.MockCallWrapper
+}
+
+// Return sets the values returned by the mock
+func (m *DieRollPingMockCall) Return(resp *emptypb.Empty, err error) *DieRollPingMockCall {
+	m.call.Return(resp, err)
+	return m
+}
+
+// Once indicates that the mock should only return the value once
+func (m *DieRollPingMockCall) Once() *DieRollPingMockCall {
+	m.call.Once()
+	return m
+}
+
+// Times indicates that the mock should only return the indicated number of times
+func (m *DieRollPingMockCall) Times(i int) *DieRollPingMockCall {
+	m.call.Times(i)
+	return m
+}
+
+// Call returns the underlying mock call, for the settings that are not wrapped
+func (m *DieRollPingMockCall) Call() *testsuite.MockCallWrapper {
+	return m.call
+}
+
+// OnActivityPing mocks the Ping activity, a nil request matches any request
+func (e *DieRollTestEnv) OnActivityPing(req *emptypb.Empty) *DieRollPingMockCall {
+	var reqMatcher interface{} = mock.Anything
+	if req != nil {
+		reqMatcher = mock.MatchedBy(func(r *emptypb.Empty) bool {
+			return proto.Equal(r, req)
+		})
+	}
+	return &DieRollPingMockCall{call: e.env.OnActivity("ping.Ping", mock.Anything, reqMatcher)}
+}
+
+// DieRollParentWorkflowMockCall is a typed mock of ParentWorkflow
+type DieRollParentWorkflowMockCall struct {
+	call *testsuite.MockCallWrapper
+}
+
+// Return sets the values returned by the mock
+func (m *DieRollParentWorkflowMockCall) Return(resp *v1.ParentWorkflowReply, err error) *DieRollParentWorkflowMockCall {
+	m.call.Return(resp, err)
+	return m
+}
+
+// Once indicates that the mock should only return the value once
+func (m *DieRollParentWorkflowMockCall) Once() *DieRollParentWorkflowMockCall {
+	m.call.Once()
+	return m
+}
+
+// Times indicates that the mock should only return the indicated number of times
+func (m *DieRollParentWorkflowMockCall) Times(i int) *DieRollParentWorkflowMockCall {
+	m.call.Times(i)
+	return m
+}
+
+// Call returns the underlying mock call, for the settings that are not wrapped
+func (m *DieRollParentWorkflowMockCall) Call() *testsuite.MockCallWrapper {
+	return m.call
+}
+
+// OnWorkflowParentWorkflow mocks the ParentWorkflow workflow when it runs as a child, a nil request matches any request
+func (e *DieRollTestEnv) OnWorkflowParentWorkflow(req *emptypb.Empty) *DieRollParentWorkflowMockCall {
+	var reqMatcher interface{} = mock.Anything
+	if req != nil {
+		reqMatcher = mock.MatchedBy(func(r *emptypb.Empty) bool {
+			return proto.Equal(r, req)
+		})
+	}
+	return &DieRollParentWorkflowMockCall{call: e.env.OnWorkflow("example.v1.DieRoll.ParentWorkflow", mock.Anything, reqMatcher)}
+}
+
+// ExecuteWorkflowParentWorkflow executes the ParentWorkflow workflow in the test environment, blocking until it completes
+func (e *DieRollTestEnv) ExecuteWorkflowParentWorkflow(req *emptypb.Empty) {
+	e.env.ExecuteWorkflow("example.v1.DieRoll.ParentWorkflow", req)
+}
+
+// ParentWorkflowResult returns the result of the ParentWorkflow workflow executed in the test environment
+func (e *DieRollTestEnv) ParentWorkflowResult() (*v1.ParentWorkflowReply, error) {
+	if !e.env.IsWorkflowCompleted() {
+		return nil, fmt.Errorf("workflow example.v1.DieRoll.ParentWorkflow is not completed")
+	}
+	var resp *v1.ParentWorkflowReply
+	err := e.env.GetWorkflowResult(&resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DieRollChildWorkflowMockCall is a typed mock of ChildWorkflow
+type DieRollChildWorkflowMockCall struct {
+	call *testsuite.MockCallWrapper
+}
+
+// Return sets the values returned by the mock
+func (m *DieRollChildWorkflowMockCall) Return(resp *emptypb.Empty, err error) *DieRollChildWorkflowMockCall {
+	m.call.Return(resp, err)
+	return m
+}
+
+// Once indicates that the mock should only return the value once
+func (m *DieRollChildWorkflowMockCall) Once() *DieRollChildWorkflowMockCall {
+	m.call.Once()
+	return m
+}
+
+// Times indicates that the mock should only return the indicated number of times
+func (m *DieRollChildWorkflowMockCall) Times(i int) *DieRollChildWorkflowMockCall {
+	m.call.Times(i)
+	return m
+}
+
+// Call returns the underlying mock call, for the settings that are not wrapped
+func (m *DieRollChildWorkflowMockCall) Call() *testsuite.MockCallWrapper {
+	return m.call
+}
+
+// OnWorkflowChildWorkflow mocks the ChildWorkflow workflow when it runs as a child, a nil request matches any request
+func (e *DieRollTestEnv) OnWorkflowChildWorkflow(req *emptypb.Empty) *DieRollChildWorkflowMockCall {
+	var reqMatcher interface{} = mock.Anything
+	if req != nil {
+		reqMatcher = mock.MatchedBy(func(r *emptypb.Empty) bool {
+			return proto.Equal(r, req)
+		})
+	}
+	return &DieRollChildWorkflowMockCall{call: e.env.OnWorkflow("example.v1.DieRoll.ChildWorkflow", mock.Anything, reqMatcher)}
+}
+
+// ExecuteWorkflowChildWorkflow executes the ChildWorkflow workflow in the test environment, blocking until it completes
+func (e *DieRollTestEnv) ExecuteWorkflowChildWorkflow(req *emptypb.Empty) {
+	e.env.ExecuteWorkflow("example.v1.DieRoll.ChildWorkflow", req)
+}
+
+// ChildWorkflowResult returns the result of the ChildWorkflow workflow executed in the test environment
+func (e *DieRollTestEnv) ChildWorkflowResult() (*emptypb.Empty, error) {
+	if !e.env.IsWorkflowCompleted() {
+		return nil, fmt.Errorf("workflow example.v1.DieRoll.ChildWorkflow is not completed")
+	}
+	var resp *emptypb.Empty
+	err := e.env.GetWorkflowResult(&resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DieRollThrowDiesMockCall is a typed mock of ThrowDies
+type DieRollThrowDiesMockCall struct {
+	call *testsuite.MockCallWrapper
+}
+
+// Return sets the values returned by the mock
+func (m *DieRollThrowDiesMockCall) Return(resp *v1.ThrowDiesResponse, err error) *DieRollThrowDiesMockCall {
+	m.call.Return(resp, err)
+	return m
+}
+
+// Once indicates that the mock should only return the value once
+func (m *DieRollThrowDiesMockCall) Once() *DieRollThrowDiesMockCall {
+	m.call.Once()
+	return m
+}
+
+// Times indicates that the mock should only return the indicated number of times
+func (m *DieRollThrowDiesMockCall) Times(i int) *DieRollThrowDiesMockCall {
+	m.call.Times(i)
+	return m
+}
+
+// Call returns the underlying mock call, for the settings that are not wrapped
+func (m *DieRollThrowDiesMockCall) Call() *testsuite.MockCallWrapper {
+	return m.call
+}
+
+// OnWorkflowThrowDies mocks the ThrowDies workflow when it runs as a child, a nil request matches any request
+func (e *DieRollTestEnv) OnWorkflowThrowDies(req *v1.ThrowDiesRequest) *DieRollThrowDiesMockCall {
+	var reqMatcher interface{} = mock.Anything
+	if req != nil {
+		reqMatcher = mock.MatchedBy(func(r *v1.ThrowDiesRequest) bool {
+			return proto.Equal(r, req)
+		})
+	}
+	return &DieRollThrowDiesMockCall{call: e.env.OnWorkflow("example.v1.DieRoll.ThrowDies", mock.Anything, reqMatcher)}
+}
+
+// ExecuteWorkflowThrowDies executes the ThrowDies workflow in the test environment, blocking until it completes
+func (e *DieRollTestEnv) ExecuteWorkflowThrowDies(req *v1.ThrowDiesRequest) {
+	e.env.ExecuteWorkflow("example.v1.DieRoll.ThrowDies", req)
+}
+
+// ThrowDiesResult returns the result of the ThrowDies workflow executed in the test environment
+func (e *DieRollTestEnv) ThrowDiesResult() (*v1.ThrowDiesResponse, error) {
+	if !e.env.IsWorkflowCompleted() {
+		return nil, fmt.Errorf("workflow example.v1.DieRoll.ThrowDies is not completed")
+	}
+	var resp *v1.ThrowDiesResponse
+	err := e.env.GetWorkflowResult(&resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DieRollThrowUntilValueMockCall is a typed mock of ThrowUntilValue
+type DieRollThrowUntilValueMockCall struct {
+	call *testsuite.MockCallWrapper
+}
+
+// Return sets the values returned by the mock
+func (m *DieRollThrowUntilValueMockCall) Return(resp *emptypb.Empty, err error) *DieRollThrowUntilValueMockCall {
+	m.call.Return(resp, err)
+	return m
+}
+
+// Once indicates that the mock should only return the value once
+func (m *DieRollThrowUntilValueMockCall) Once() *DieRollThrowUntilValueMockCall {
+	m.call.Once()
+	return m
+}
+
+// Times indicates that the mock should only return the indicated number of times
+func (m *DieRollThrowUntilValueMockCall) Times(i int) *DieRollThrowUntilValueMockCall {
+	m.call.Times(i)
+	return m
+}
+
+// Call returns the underlying mock call, for the settings that are not wrapped
+func (m *DieRollThrowUntilValueMockCall) Call() *testsuite.MockCallWrapper {
+	return m.call
+}
+
+// OnWorkflowThrowUntilValue mocks the ThrowUntilValue workflow when it runs as a child, a nil request matches any request
+func (e *DieRollTestEnv) OnWorkflowThrowUntilValue(req *v1.ThrowUntilValueRequest) *DieRollThrowUntilValueMockCall {
+	var reqMatcher interface{} = mock.Anything
+	if req != nil {
+		reqMatcher = mock.MatchedBy(func(r *v1.ThrowUntilValueRequest) bool {
+			return proto.Equal(r, req)
+		})
+	}
+	return &DieRollThrowUntilValueMockCall{call: e.env.OnWorkflow("example.v1.DieRoll.ThrowUntilValue", mock.Anything, reqMatcher)}
+}
+
+// ExecuteWorkflowThrowUntilValue executes the ThrowUntilValue workflow in the test environment, blocking until it completes
+func (e *DieRollTestEnv) ExecuteWorkflowThrowUntilValue(req *v1.ThrowUntilValueRequest) {
+	e.env.ExecuteWorkflow("example.v1.DieRoll.ThrowUntilValue", req)
+}
+
+// ThrowUntilValueResult returns the result of the ThrowUntilValue workflow executed in the test environment
+func (e *DieRollTestEnv) ThrowUntilValueResult() (*emptypb.Empty, error) {
+	if !e.env.IsWorkflowCompleted() {
+		return nil, fmt.Errorf("workflow example.v1.DieRoll.ThrowUntilValue is not completed")
+	}
+	var resp *emptypb.Empty
+	err := e.env.GetWorkflowResult(&resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SignalContinue sends the Continue signal to the workflow executed in the test environment
+func (e *DieRollTestEnv) SignalContinue(req *v1.ContinueSignalRequest) {
+	e.env.SignalWorkflow("example.v1.DieRoll.Continue", req)
+}
+
+// SignalContinueDelayed sends the Continue signal to the workflow executed in the test environment after a delay
+// It must be called before the workflow is executed
+func (e *DieRollTestEnv) SignalContinueDelayed(delay time.Duration, req *v1.ContinueSignalRequest) {
+	e.env.RegisterDelayedCallback(func() {
+		e.env.SignalWorkflow("example.v1.DieRoll.Continue", req)
+	}, delay)
+}
+
+// QueryGetThrowsStatus queries the workflow executed in the test environment with GetThrowsStatus
+func (e *DieRollTestEnv) QueryGetThrowsStatus(req *emptypb.Empty) (*v1.ThrowStatusResponse, error) {
+	value, err := e.env.QueryWorkflow("example.v1.DieRoll.GetThrowsStatus", req)
+	if err != nil {
+		return nil, err
+	}
+	var resp *v1.ThrowStatusResponse
+	err = value.Get(&resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -8,6 +8,8 @@ require (
 	github.com/charmbracelet/log v0.4.0
 	github.com/dave/jennifer v1.7.1
 	github.com/google/uuid v1.6.0
+	github.com/stretchr/testify v1.9.0
+	go.temporal.io/api v1.44.1
 	go.temporal.io/sdk v1.30.0
 	google.golang.org/protobuf v1.36.6
 )
@@ -33,8 +35,6 @@ require (
 	github.com/rivo/uniseg v0.4.7 // indirect
 	github.com/robfig/cron v1.2.0 // indirect
 	github.com/stretchr/objx v0.5.2 // indirect
-	github.com/stretchr/testify v1.9.0 // indirect
-	go.temporal.io/api v1.44.1 // indirect
 	golang.org/x/exp v0.0.0-20231127185646-65229373498e // indirect
 	golang.org/x/net v0.39.0 // indirect
 	golang.org/x/sync v0.13.0 // indirect
@@ -0,0 +1,196 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+
+	"github.com/dave/jennifer/jen"
+	temporalv1 "github.com/thomas-maurice/protoc-gen-go-tmprl/gen/temporal/v1"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func getErrorTypeName(message *protogen.Message) string {
+	return fmt.Sprintf("%sErrorType", message.GoIdent.GoName)
+}
+
+func getNewErrorName(message *protogen.Message) string {
+	return fmt.Sprintf("New%sError", message.GoIdent.GoName)
+}
+
+func getAsErrorName(message *protogen.Message) string {
+	return fmt.Sprintf("As%sError", message.GoIdent.GoName)
+}
+
+// getMethodErrors returns the names of the error messages declared by a
+// method, on top of the ones declared in the service defaults
+func getMethodErrors(service *protogen.Service, method *protogen.Method) ([]string, error) {
+	t, err := getMethodType(method)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := make([]string, 0)
+	switch t {
+	case MethodTypeActivity:
+		errs = append(errs, getDefaultActivityOptions(service).GetErrors()...)
+		errs = append(errs, getActivityOptions(method).GetErrors()...)
+	case MethodTypeWorkflow:
+		errs = append(errs, getDefaultWorkflowOptions(service).GetErrors()...)
+		errs = append(errs, getWorkflowOptions(method).GetErrors()...)
+	}
+
+	return errs, nil
+}
+
+// getApplicationErrors returns the messages used as error details by the
+// temporal services of a file, each of them once
+func getApplicationErrors(file *protogen.File, config *Config) ([]*protogen.Message, error) {
+	messages := make([]*protogen.Message, 0)
+	seen := make(map[protoreflect.FullName]bool)
+	names := make(map[string]*protogen.Message)
+
+	for _, service := range file.Services {
+		if so, ok := proto.GetExtension(service.Desc.Options(), temporalv1.E_Service).(*temporalv1.ServiceOptions); !ok || so == nil {
+			continue
+		}
+
+		for _, method := range service.Methods {
+			errs, err := getMethodErrors(service, method)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, name := range errs {
+				message, err := config.registry.findMessage(service, name)
+				if err != nil {
+					return nil, fmt.Errorf("invalid errors for %s: %w", method.Desc.FullName(), err)
+				}
+
+				if seen[message.Desc.FullName()] {
+					continue
+				}
+
+				if other, ok := names[message.GoIdent.GoName]; ok {
+					return nil, fmt.Errorf("error messages %s and %s would generate the same functions", other.Desc.FullName(), message.Desc.FullName())
+				}
+
+				seen[message.Desc.FullName()] = true
+				names[message.GoIdent.GoName] = message
+				messages = append(messages, message)
+			}
+		}
+	}
+
+	return messages, nil
+}
+
+// getDeclaredApplicationErrors returns the application errors of a file whose
+// functions it declares, the other ones being declared by another file of the
+// same Go package
+func getDeclaredApplicationErrors(file *protogen.File, config *Config) ([]*protogen.Message, error) {
+	messages, err := getApplicationErrors(file, config)
+	if err != nil {
+		return nil, err
+	}
+
+	declared := make([]*protogen.Message, 0)
+	for _, message := range messages {
+		declaring, err := config.registry.declaringFile(file, message.Desc, func(f *protogen.File) (bool, error) {
+			used, err := getApplicationErrors(f, config)
+			return slices.Contains(used, message), err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if declaring == file {
+			declared = append(declared, message)
+		}
+	}
+
+	return declared, nil
+}
+
+// ApplicationErrors generates the constructors and extractors of the typed
+// application errors of a file. They are generated per file rather than per
+// service as several services can return the same errors, and only once per
+// Go package
+func ApplicationErrors(gf *protogen.GeneratedFile, file *protogen.File, config *Config) error {
+	messages, err := getDeclaredApplicationErrors(file, config)
+	if err != nil {
+		return err
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+
+	appErrors := jen.Null()
+
+	for _, message := range messages {
+		detailsType := gf.QualifiedGoIdent(message.GoIdent)
+		errorType := getErrorTypeName(message)
+
+		appErrors.Comment(fmt.Sprintf("%s is the type of the application errors carrying %s details", errorType, message.GoIdent.GoName)).Line().
+			Const().Id(errorType).Op("=").Lit(string(message.Desc.FullName())).Line().Line()
+
+		appErrors.Comment(fmt.Sprintf("%s returns an application error carrying %s details", getNewErrorName(message), message.GoIdent.GoName)).Line().
+			Comment("It can be returned by activities and workflows, a non retryable error is not retried regardless of the retry policy").Line().
+			Func().Id(getNewErrorName(message)).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Id("msg").String())
+			g.Add(jen.Id("details").Op("*").Id(detailsType))
+			g.Add(jen.Id("nonRetryable").Bool())
+		}).Error().BlockFunc(func(g *jen.Group) {
+			g.Add(jen.Return(jen.Id(getTemporalObject(gf, "NewApplicationErrorWithOptions")).Call(
+				jen.Id("msg"),
+				jen.Id(errorType),
+				jen.Id(getTemporalObject(gf, "ApplicationErrorOptions")).Values(jen.Dict{
+					jen.Id("NonRetryable"): jen.Id("nonRetryable"),
+					jen.Id("Details"):      jen.Index().Interface().Values(jen.Id("details")),
+				}),
+			)))
+		}).Line().Line()
+
+		appErrors.Comment(fmt.Sprintf("%s returns the details of the %s application error found in the chain of `err`,", getAsErrorName(message), message.GoIdent.GoName)).Line().
+			Comment("looking through the activity, child workflow and workflow execution errors wrapping it").Line().
+			Func().Id(getAsErrorName(message)).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Err().Error())
+		}).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Op("*").Id(detailsType))
+			g.Add(jen.Bool())
+		}).BlockFunc(func(g *jen.Group) {
+			g.Add(jen.For(jen.Err().Op("!=").Nil()).BlockFunc(func(g *jen.Group) {
+				g.Add(jen.Var().Id("appErr").Op("*").Id(getTemporalObject(gf, "ApplicationError")))
+				g.Add(jen.If(jen.Op("!").Id(getErrorsObject(gf, "As")).Call(jen.Err(), jen.Op("&").Id("appErr"))).Block(
+					jen.Return(jen.Nil(), jen.False()),
+				))
+
+				g.Add(jen.If(jen.Id("appErr").Dot("Type").Call().Op("==").Id(errorType)).BlockFunc(func(g *jen.Group) {
+					g.Add(jen.Var().Id("details").Op("*").Id(detailsType))
+					g.Add(jen.If(jen.Id("appErr").Dot("HasDetails").Call()).Block(
+						jen.If(jen.Err().Op(":=").Id("appErr").Dot("Details").Call(jen.Op("&").Id("details")), jen.Err().Op("!=").Nil()).Block(
+							jen.Return(jen.Nil(), jen.False()),
+						),
+					))
+					g.Add(jen.Return(jen.Id("details"), jen.True()))
+				}))
+
+				g.Add(jen.Err().Op("=").Id("appErr").Dot("Unwrap").Call())
+			}))
+
+			g.Add(jen.Return(jen.Nil(), jen.False()))
+		}).Line().Line()
+	}
+
+	buf := bytes.NewBufferString("")
+	if err := appErrors.Render(buf); err != nil {
+		return err
+	}
+
+	gf.P(buf.String())
+
+	return nil
+}
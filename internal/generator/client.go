@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	"github.com/dave/jennifer/jen"
+	temporalv1 "github.com/thomas-maurice/protoc-gen-go-tmprl/gen/temporal/v1"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
 )
 
 func getClientName(service *protogen.Service) string {
@@ -20,13 +22,22 @@ func Client(gf *protogen.GeneratedFile, service *protogen.Service, config *Confi
 		StructFunc(func(g *jen.Group) {
 			g.Add(jen.Id("client").Id(getTemporalClientObject(gf, "Client")))
 			g.Add(jen.Id("taskQueue").String())
+			g.Add(jen.Id("interceptors").Index().Id(getClientInterceptorName(service)))
+			if config.ProtoConverter != "" {
+				// only the clients dialed by the constructors are closed by Close
+				g.Add(jen.Id("dialed").Bool())
+				// the data converter the constructors dialed with, to decode the memos
+				g.Add(jen.Id("dataConverter").Id(getTemporalConverterObject(gf, "DataConverter")))
+			}
 		}).Line().Line().
 		// New client func
 		Comment(fmt.Sprintf("New%s: Returns a new instance of the client.", clientName)).Line().
+		Comment("Its calls run through the `interceptors`, which can be nil, the first one being the outermost.").Line().
 		Comment("If `taskQueue` stays empty the default one will be used").Line().
 		Func().Id(fmt.Sprintf("New%s", clientName)).
 		ParamsFunc(func(g *jen.Group) {
 			g.Add(jen.Id("client").Id(getTemporalClientObject(gf, "Client")))
+			g.Add(jen.Id("interceptors").Index().Id(getClientInterceptorName(service)))
 			g.Add(jen.Id("taskQueue").Op("...").String())
 		}).
 		Parens(jen.ListFunc(func(g *jen.Group) {
@@ -45,6 +56,7 @@ func Client(gf *protogen.GeneratedFile, service *protogen.Service, config *Confi
 						g.Add(jen.Op("&").Id(clientName).BlockFunc(func(g *jen.Group) {
 							g.Add(jen.Id("client").Op(":").Id("client")).Op(",")
 							g.Add(jen.Id("taskQueue").Op(":").Id("clientTaskQueue")).Op(",")
+							g.Add(jen.Id("interceptors").Op(":").Id("interceptors")).Op(",")
 						}),
 						)
 						g.Add(jen.Nil())
@@ -70,41 +82,52 @@ func Client(gf *protogen.GeneratedFile, service *protogen.Service, config *Confi
 		if activityOptions == nil {
 			activityOptions = defaultActivityOptions
 		} else if defaultActivityOptions != nil {
-			if activityOptions.ScheduleToStartTimeout == nil {
-				activityOptions.ScheduleToStartTimeout = defaultActivityOptions.ScheduleToStartTimeout
+			// do not alter the options of the method, other generators read them
+			activityOptions = proto.Clone(activityOptions).(*temporalv1.ActivityOptions)
+			if activityOptions.ScheduleToStartTimeoutDuration == nil {
+				activityOptions.ScheduleToStartTimeoutDuration = defaultActivityOptions.ScheduleToStartTimeoutDuration
 			}
-			if activityOptions.ScheduleToCloseTimeout == nil {
-				activityOptions.ScheduleToCloseTimeout = defaultActivityOptions.ScheduleToCloseTimeout
+			if activityOptions.ScheduleToCloseTimeoutDuration == nil {
+				activityOptions.ScheduleToCloseTimeoutDuration = defaultActivityOptions.ScheduleToCloseTimeoutDuration
 			}
-			if activityOptions.StartToCloseTimeout == nil {
-				activityOptions.StartToCloseTimeout = defaultActivityOptions.StartToCloseTimeout
+			if activityOptions.StartToCloseTimeoutDuration == nil {
+				activityOptions.StartToCloseTimeoutDuration = defaultActivityOptions.StartToCloseTimeoutDuration
 			}
 			if activityOptions.RetryPolicy == nil {
 				activityOptions.RetryPolicy = defaultActivityOptions.RetryPolicy
 			}
 		}
 
-		workflowOptions := getWorkflowOptions(method)
-		defaultWorkflowOptions := getDefaultWorkflowOptions(service)
-		if workflowOptions == nil {
-			workflowOptions = defaultWorkflowOptions
-		} else if defaultActivityOptions != nil {
-			if workflowOptions.WorkflowExecutionTimeout == nil {
-				workflowOptions.WorkflowExecutionTimeout = defaultWorkflowOptions.WorkflowExecutionTimeout
-			}
-			if workflowOptions.WorkflowRunTimeout == nil {
-				workflowOptions.WorkflowRunTimeout = defaultWorkflowOptions.WorkflowRunTimeout
-			}
-			if workflowOptions.WorkflowTaskTimeout == nil {
-				workflowOptions.WorkflowTaskTimeout = defaultWorkflowOptions.WorkflowTaskTimeout
+		if activityOptions.GetRetryPolicy().GetNonRetryableErrorEnum() != "" {
+			activityOptions = proto.Clone(activityOptions).(*temporalv1.ActivityOptions)
+			activityOptions.RetryPolicy, err = config.registry.expandRetryPolicy(service, activityOptions.RetryPolicy)
+			if err != nil {
+				return fmt.Errorf("invalid retry policy for %s: %w", method.Desc.FullName(), err)
 			}
-			if workflowOptions.RetryPolicy == nil {
-				workflowOptions.RetryPolicy = defaultWorkflowOptions.RetryPolicy
+		}
+
+		workflowOptions := getMergedWorkflowOptions(service, method)
+		workflowOptions.RetryPolicy, err = config.registry.expandRetryPolicy(service, workflowOptions.RetryPolicy)
+		if err != nil {
+			return fmt.Errorf("invalid retry policy for %s: %w", method.Desc.FullName(), err)
+		}
+
+		// workflow ID built from the request, if the workflow has a template
+		var workflowID *jen.Statement
+		if template := getWorkflowOptions(method).GetIdTemplate(); t == MethodTypeWorkflow && template != "" {
+			workflowID, err = workflowIDFromTemplate(gf, method, template, "req")
+			if err != nil {
+				return err
 			}
 		}
 
 		switch t {
 		case MethodTypeWorkflow:
+			hook, err := getClientHook(gf, service, config, fmt.Sprintf("ExecuteWorkflow%s", method.GoName))
+			if err != nil {
+				return err
+			}
+
 			// Executes a new workflow from the client asynchronously
 			client.Comment(fmt.Sprintf("ExecuteWorkflow%s executes the workflow and returns a future to it", method.GoName)).Line().
 				Func().Parens(jen.Id("c").Op("*").Id(clientName)).Id(fmt.Sprintf("ExecuteWorkflow%s", method.GoName)).ParamsFunc(func(g *jen.Group) {
@@ -116,86 +139,31 @@ func Client(gf *protogen.GeneratedFile, service *protogen.Service, config *Confi
 				g.Add(jen.Error())
 			}).
 				BlockFunc(func(g *jen.Group) {
-					g.Add(jen.Id("wOptions").Op(":=").Id(getTemporalClientObject(gf, "StartWorkflowOptions")).Block())
+					g.Add(jen.Id("opts").Op(":=").Id(getTemporalClientObject(gf, "StartWorkflowOptions")).Block())
 					g.Add(jen.If(jen.Len(jen.Id("options")).Op(">").Lit(0).Block(
-						jen.Id("wOptions").Op("=").Id("options").Index(jen.Lit(0)),
+						jen.Id("opts").Op("=").Id("options").Index(jen.Lit(0)),
 					)))
-					g.Add(jen.If(jen.Id("wOptions").Dot("TaskQueue").Op("==").Lit("")).BlockFunc(func(g *jen.Group) {
-						g.Add(jen.Id("wOptions").Dot("TaskQueue").Op("=").Id("c").Dot("taskQueue"))
-					}))
-					g.Add(jen.If(jen.Id("wOptions").Dot("TaskQueue").Op("==").Lit("")).BlockFunc(func(g *jen.Group) {
-						g.Add(jen.Id("wOptions").Dot("TaskQueue").Op("=").Id(fmt.Sprintf("Default%sTaskQueueName", service.GoName)))
-					}))
-
-					if config.GenWorkflowPrefix {
-						g.Add(
-							jen.If(jen.Id("wOptions").Dot("ID").Op("==").Lit("")).BlockFunc(func(g *jen.Group) {
-								g.Add(jen.Id("wOptions").Dot("ID").Op("=").Id(getFmtObject(gf, "Sprintf")).CallFunc(func(g *jen.Group) {
-									g.Add(jen.Lit("%s/%s"))
-									g.Add(jen.Lit(methName))
-									g.Add(jen.Id(getUUIDObject(gf, "NewString")).Parens(jen.Null()))
-								}))
-							},
-							),
-						)
+					for _, s := range hook.chain() {
+						g.Add(s)
 					}
+				}).Line().Line()
 
-					if workflowOptions != nil {
-						if workflowOptions.WorkflowExecutionTimeout != nil {
-							g.Add(jen.If(jen.Id("wOptions").Dot("WorkflowExecutionTimeout").Op("==").Lit(0)).BlockFunc(func(g *jen.Group) {
-								g.Add(jen.Id("wOptions").Dot("WorkflowExecutionTimeout").Op("=").Id(getTimeObject(gf, "Duration")).CallFunc(func(g *jen.Group) {
-									g.Add(jen.Lit(*workflowOptions.WorkflowExecutionTimeout))
-								}).Op("*").Id(getTimeObject(gf, "Second")))
-							}))
-						}
-
-						if workflowOptions.WorkflowRunTimeout != nil {
-							g.Add(jen.If(jen.Id("wOptions").Dot("WorkflowRunTimeout").Op("==").Lit(0)).BlockFunc(func(g *jen.Group) {
-								g.Add(jen.Id("wOptions").Dot("WorkflowRunTimeout").Op("=").Id(getTimeObject(gf, "Duration")).CallFunc(func(g *jen.Group) {
-									g.Add(jen.Lit(*workflowOptions.WorkflowRunTimeout))
-								}).Op("*").Id(getTimeObject(gf, "Second")))
-							}))
-						}
-
-						if workflowOptions.WorkflowTaskTimeout != nil {
-							g.Add(jen.If(jen.Id("wOptions").Dot("WorkflowTaskTimeout").Op("==").Lit(0)).BlockFunc(func(g *jen.Group) {
-								g.Add(jen.Id("wOptions").Dot("WorkflowTaskTimeout").Op("=").Id(getTimeObject(gf, "Duration")).CallFunc(func(g *jen.Group) {
-									g.Add(jen.Lit(*workflowOptions.WorkflowRunTimeout))
-								}).Op("*").Id(getTimeObject(gf, "Second")))
-							}))
-						}
-
-						if workflowOptions.RetryPolicy != nil {
-							g.Add(jen.If(jen.Id("wOptions").Dot("RetryPolicy").Op("==").Nil())).BlockFunc(func(g *jen.Group) {
-								g.Add(jen.Id("wOptions").Dot("RetryPolicy").Op("=").Op("&").Id(getTemporalObject(gf, "RetryPolicy")).BlockFunc(func(g *jen.Group) {
-									if workflowOptions.RetryPolicy.InitialInterval != nil {
-										g.Add(jen.Id("InitialInterval").Op(":").Id(getTimeObject(gf, "Duration")).CallFunc(func(g *jen.Group) {
-											g.Add(jen.Lit(*workflowOptions.RetryPolicy.InitialInterval))
-										}).Op("*").Id(getTimeObject(gf, "Second")).Op(","))
-									}
-									if workflowOptions.RetryPolicy.MaximumInterval != nil {
-										g.Add(jen.Id("MaximumInterval").Op(":").Id(getTimeObject(gf, "Duration")).CallFunc(func(g *jen.Group) {
-											g.Add(jen.Lit(*workflowOptions.RetryPolicy.MaximumInterval))
-										}).Op("*").Id(getTimeObject(gf, "Second")).Op(","))
-									}
-									if workflowOptions.RetryPolicy.BackoffCoefficient != nil {
-										g.Add(jen.Id("BackoffCoefficient").Op(":").Float64().Call(jen.Lit(*workflowOptions.RetryPolicy.BackoffCoefficient)).Op(","))
-									}
-									if workflowOptions.RetryPolicy.MaximumAttempts != nil {
-										g.Add(jen.Id("MaximumAttempts").Op(":").Lit(*workflowOptions.RetryPolicy.MaximumAttempts).Op(","))
-									}
-									if workflowOptions.RetryPolicy.NonRetryableErrorTypes != nil {
-										g.Add(jen.Id("NonRetryableErrorTypes").Op(":").Index(jen.Null()).String().Block(jen.ListFunc(func(g *jen.Group) {
-											for _, errType := range activityOptions.RetryPolicy.NonRetryableErrorTypes {
-												g.Lit(errType)
-											}
-										})).Op(","))
-									}
-								}))
-							})
-						}
+			client.Comment(fmt.Sprintf("%s executes the workflow once the client interceptors ran", hook.getImplName())).Line().
+				Func().Parens(jen.Id("c").Op("*").Id(clientName)).Id(hook.getImplName()).ParamsFunc(func(g *jen.Group) {
+				g.Add(jen.Id("ctx").Id(getContext(gf)))
+				g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent)))
+				g.Add(jen.Id("wOptions").Id(getTemporalClientObject(gf, "StartWorkflowOptions")))
+			}).ParamsFunc(func(g *jen.Group) {
+				g.Add(jen.Id(getTemporalClientObject(gf, "WorkflowRun")))
+				g.Add(jen.Error())
+			}).
+				BlockFunc(func(g *jen.Group) {
+					if validatesBeforeSending(method, config) {
+						g.Add(validateRequest(service, methName, "req", jen.Return(jen.Nil(), jen.Err())))
 					}
 
+					startWorkflowOptionsDefaults(gf, g, service, method, methName, workflowOptions, workflowID, "req", config)
+
 					g.Add(jen.ReturnFunc(func(g *jen.Group) {
 						g.Add(jen.Id("c").Dot("client").Dot("ExecuteWorkflow").CallFunc(func(g *jen.Group) {
 							g.Add(jen.Id("ctx"))
@@ -283,6 +251,10 @@ func Client(gf *protogen.GeneratedFile, service *protogen.Service, config *Confi
 				g.Add(jen.Error())
 			}).
 				BlockFunc(func(g *jen.Group) {
+					if validatesBeforeSending(method, config) {
+						g.Add(validateRequest(service, methName, "req", jen.Return(jen.Nil(), jen.Err())))
+					}
+
 					g.Add(jen.Id("wOptions").Op(":=").Id(getTemporalWorkflowObject(gf, "ChildWorkflowOptions")).Block())
 					g.Add(jen.If(jen.Len(jen.Id("options")).Op(">").Lit(0).Block(
 						jen.Id("wOptions").Op("=").Id("options").Index(jen.Lit(0)),
@@ -296,7 +268,11 @@ func Client(gf *protogen.GeneratedFile, service *protogen.Service, config *Confi
 						g.Add(jen.Id("wOptions").Dot("TaskQueue").Op("=").Id(fmt.Sprintf("Default%sTaskQueueName", service.GoName)))
 					}))
 
-					if config.GenWorkflowPrefix {
+					if workflowID != nil {
+						g.Add(jen.If(jen.Id("wOptions").Dot("WorkflowID").Op("==").Lit("")).Block(
+							jen.Id("wOptions").Dot("WorkflowID").Op("=").Add(workflowID.Clone()),
+						))
+					} else if config.GenWorkflowPrefix {
 						// we use side effects here to ensure that the workflow history won't be altered in case of replay
 						g.Add(
 							jen.If(jen.Id("wOptions").Dot("WorkflowID").Op("==").Lit("")).BlockFunc(func(g *jen.Group) {
@@ -323,42 +299,32 @@ func Client(gf *protogen.GeneratedFile, service *protogen.Service, config *Confi
 					}
 
 					if workflowOptions != nil {
-						if workflowOptions.WorkflowExecutionTimeout != nil {
+						if workflowOptions.WorkflowExecutionTimeoutDuration != nil {
 							g.Add(jen.If(jen.Id("wOptions").Dot("WorkflowExecutionTimeout").Op("==").Lit(0)).BlockFunc(func(g *jen.Group) {
-								g.Add(jen.Id("wOptions").Dot("WorkflowExecutionTimeout").Op("=").Id(getTimeObject(gf, "Duration")).CallFunc(func(g *jen.Group) {
-									g.Add(jen.Lit(*workflowOptions.WorkflowExecutionTimeout))
-								}).Op("*").Id(getTimeObject(gf, "Second")))
+								g.Add(jen.Id("wOptions").Dot("WorkflowExecutionTimeout").Op("=").Add(duration(gf, workflowOptions.WorkflowExecutionTimeoutDuration)))
 							}))
 						}
 
-						if workflowOptions.WorkflowRunTimeout != nil {
+						if workflowOptions.WorkflowRunTimeoutDuration != nil {
 							g.Add(jen.If(jen.Id("wOptions").Dot("WorkflowRunTimeout").Op("==").Lit(0)).BlockFunc(func(g *jen.Group) {
-								g.Add(jen.Id("wOptions").Dot("WorkflowRunTimeout").Op("=").Id(getTimeObject(gf, "Duration")).CallFunc(func(g *jen.Group) {
-									g.Add(jen.Lit(*workflowOptions.WorkflowRunTimeout))
-								}).Op("*").Id(getTimeObject(gf, "Second")))
+								g.Add(jen.Id("wOptions").Dot("WorkflowRunTimeout").Op("=").Add(duration(gf, workflowOptions.WorkflowRunTimeoutDuration)))
 							}))
 						}
 
-						if workflowOptions.WorkflowTaskTimeout != nil {
+						if workflowOptions.WorkflowTaskTimeoutDuration != nil {
 							g.Add(jen.If(jen.Id("wOptions").Dot("WorkflowTaskTimeout").Op("==").Lit(0)).BlockFunc(func(g *jen.Group) {
-								g.Add(jen.Id("wOptions").Dot("WorkflowTaskTimeout").Op("=").Id(getTimeObject(gf, "Duration")).CallFunc(func(g *jen.Group) {
-									g.Add(jen.Lit(*workflowOptions.WorkflowRunTimeout))
-								}).Op("*").Id(getTimeObject(gf, "Second")))
+								g.Add(jen.Id("wOptions").Dot("WorkflowTaskTimeout").Op("=").Add(duration(gf, workflowOptions.WorkflowTaskTimeoutDuration)))
 							}))
 						}
 
 						if workflowOptions.RetryPolicy != nil {
 							g.Add(jen.If(jen.Id("wOptions").Dot("RetryPolicy").Op("==").Nil())).BlockFunc(func(g *jen.Group) {
 								g.Add(jen.Id("wOptions").Dot("RetryPolicy").Op("=").Op("&").Id(getTemporalObject(gf, "RetryPolicy")).BlockFunc(func(g *jen.Group) {
-									if workflowOptions.RetryPolicy.InitialInterval != nil {
-										g.Add(jen.Id("InitialInterval").Op(":").Id(getTimeObject(gf, "Duration")).CallFunc(func(g *jen.Group) {
-											g.Add(jen.Lit(*workflowOptions.RetryPolicy.InitialInterval))
-										}).Op("*").Id(getTimeObject(gf, "Second")).Op(","))
+									if workflowOptions.RetryPolicy.InitialIntervalDuration != nil {
+										g.Add(jen.Id("InitialInterval").Op(":").Add(duration(gf, workflowOptions.RetryPolicy.InitialIntervalDuration)).Op(","))
 									}
-									if workflowOptions.RetryPolicy.MaximumInterval != nil {
-										g.Add(jen.Id("MaximumInterval").Op(":").Id(getTimeObject(gf, "Duration")).CallFunc(func(g *jen.Group) {
-											g.Add(jen.Lit(*workflowOptions.RetryPolicy.MaximumInterval))
-										}).Op("*").Id(getTimeObject(gf, "Second")).Op(","))
+									if workflowOptions.RetryPolicy.MaximumIntervalDuration != nil {
+										g.Add(jen.Id("MaximumInterval").Op(":").Add(duration(gf, workflowOptions.RetryPolicy.MaximumIntervalDuration)).Op(","))
 									}
 									if workflowOptions.RetryPolicy.BackoffCoefficient != nil {
 										g.Add(jen.Id("BackoffCoefficient").Op(":").Float64().Call(jen.Lit(*workflowOptions.RetryPolicy.BackoffCoefficient)).Op(","))
@@ -367,8 +333,8 @@ func Client(gf *protogen.GeneratedFile, service *protogen.Service, config *Confi
 										g.Add(jen.Id("MaximumAttempts").Op(":").Lit(*workflowOptions.RetryPolicy.MaximumAttempts).Op(","))
 									}
 									if workflowOptions.RetryPolicy.NonRetryableErrorTypes != nil {
-										g.Add(jen.Id("NonRetryableErrorTypes").Op(":").Index(jen.Null()).String().Block(jen.ListFunc(func(g *jen.Group) {
-											for _, errType := range activityOptions.RetryPolicy.NonRetryableErrorTypes {
+										g.Add(jen.Id("NonRetryableErrorTypes").Op(":").Index(jen.Null()).String().Values(jen.ListFunc(func(g *jen.Group) {
+											for _, errType := range workflowOptions.RetryPolicy.NonRetryableErrorTypes {
 												g.Lit(errType)
 											}
 										})).Op(","))
@@ -378,6 +344,34 @@ func Client(gf *protogen.GeneratedFile, service *protogen.Service, config *Confi
 						}
 					}
 
+					if workflowOptions.IdReusePolicy != temporalv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
+						g.Add(jen.If(jen.Id("wOptions").Dot("WorkflowIDReusePolicy").Op("==").Id(getTemporalEnumsObject(gf, "WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED"))).Block(
+							jen.Id("wOptions").Dot("WorkflowIDReusePolicy").Op("=").Id(getTemporalEnumsObject(gf, workflowOptions.IdReusePolicy.String())),
+						))
+					}
+
+					if workflowOptions.ParentClosePolicy != temporalv1.ParentClosePolicy_PARENT_CLOSE_POLICY_UNSPECIFIED {
+						g.Add(jen.If(jen.Id("wOptions").Dot("ParentClosePolicy").Op("==").Id(getTemporalEnumsObject(gf, "PARENT_CLOSE_POLICY_UNSPECIFIED"))).Block(
+							jen.Id("wOptions").Dot("ParentClosePolicy").Op("=").Id(getTemporalEnumsObject(gf, workflowOptions.ParentClosePolicy.String())),
+						))
+					}
+
+					// a false value cannot be told apart from an unset one, so it is
+					// only applied when the caller did not pass any options
+					if workflowOptions.GetWaitForCancellation() {
+						g.Add(jen.If(jen.Len(jen.Id("options")).Op("==").Lit(0)).Block(
+							jen.Id("wOptions").Dot("WaitForCancellation").Op("=").True(),
+						))
+					}
+
+					if hasSearchAttributes(method) {
+						g.Add(searchAttributesDefault(gf, service, method, jen.Id("wOptions"), "req"))
+					}
+
+					if hasMemo(method) {
+						g.Add(memoDefault(service, method, jen.Id("wOptions"), "req"))
+					}
+
 					g.Add(jen.ReturnFunc(func(g *jen.Group) {
 						g.Add(jen.Id(getTemporalWorkflowObject(gf, "ExecuteChildWorkflow")).CallFunc(func(g *jen.Group) {
 							g.Add(jen.Id(getTemporalWorkflowObject(gf, "WithChildOptions")).CallFunc(func(g *jen.Group) {
@@ -437,6 +431,10 @@ func Client(gf *protogen.GeneratedFile, service *protogen.Service, config *Confi
 				g.Add(jen.Id(getTemporalWorkflowObject(gf, "Future")))
 			}).
 				BlockFunc(func(g *jen.Group) {
+					if validatesBeforeSending(method, config) {
+						g.Add(validateRequestFuture(gf, service, methName, "req"))
+					}
+
 					g.Add(jen.Var().Id("aOptions").Id(getTemporalWorkflowObject(gf, "ActivityOptions")))
 
 					g.Add(jen.If(jen.Len(jen.Id("options")).Op(">").Lit(0).Block(
@@ -455,19 +453,15 @@ func Client(gf *protogen.GeneratedFile, service *protogen.Service, config *Confi
 					)
 
 					if activityOptions != nil {
-						if activityOptions.StartToCloseTimeout != nil {
+						if activityOptions.StartToCloseTimeoutDuration != nil {
 							g.Add(jen.If(jen.Id("aOptions").Dot("StartToCloseTimeout").Op("==").Lit(0)).BlockFunc(func(g *jen.Group) {
-								g.Add(jen.Id("aOptions").Dot("StartToCloseTimeout").Op("=").Id(getTimeObject(gf, "Duration")).CallFunc(func(g *jen.Group) {
-									g.Add(jen.Lit(*activityOptions.StartToCloseTimeout))
-								}).Op("*").Id(getTimeObject(gf, "Second")))
+								g.Add(jen.Id("aOptions").Dot("StartToCloseTimeout").Op("=").Add(duration(gf, activityOptions.StartToCloseTimeoutDuration)))
 							}))
 						}
 
-						if activityOptions.ScheduleToCloseTimeout != nil {
+						if activityOptions.ScheduleToCloseTimeoutDuration != nil {
 							g.Add(jen.If(jen.Id("aOptions").Dot("ScheduleToCloseTimeout").Op("==").Lit(0)).BlockFunc(func(g *jen.Group) {
-								g.Add(jen.Id("aOptions").Dot("ScheduleToCloseTimeout").Op("=").Id(getTimeObject(gf, "Duration")).CallFunc(func(g *jen.Group) {
-									g.Add(jen.Lit(*activityOptions.ScheduleToCloseTimeout))
-								}).Op("*").Id(getTimeObject(gf, "Second")))
+								g.Add(jen.Id("aOptions").Dot("ScheduleToCloseTimeout").Op("=").Add(duration(gf, activityOptions.ScheduleToCloseTimeoutDuration)))
 							}))
 						} else {
 							g.Add(jen.If(jen.Id("aOptions").Dot("ScheduleToCloseTimeout").Op("==").Lit(0)).BlockFunc(func(g *jen.Group) {
@@ -477,34 +471,26 @@ func Client(gf *protogen.GeneratedFile, service *protogen.Service, config *Confi
 							}))
 						}
 
-						if activityOptions.ScheduleToStartTimeout != nil {
+						if activityOptions.ScheduleToStartTimeoutDuration != nil {
 							g.Add(jen.If(jen.Id("aOptions").Dot("ScheduleToStartTimeout").Op("==").Lit(0)).BlockFunc(func(g *jen.Group) {
-								g.Add(jen.Id("aOptions").Dot("ScheduleToStartTimeout").Op("=").Id(getTimeObject(gf, "Duration")).CallFunc(func(g *jen.Group) {
-									g.Add(jen.Lit(*activityOptions.ScheduleToStartTimeout))
-								}).Op("*").Id(getTimeObject(gf, "Second")))
+								g.Add(jen.Id("aOptions").Dot("ScheduleToStartTimeout").Op("=").Add(duration(gf, activityOptions.ScheduleToStartTimeoutDuration)))
 							}))
 						}
 
-						if activityOptions.HeartbeatTimeout != nil {
+						if activityOptions.HeartbeatTimeoutDuration != nil {
 							g.Add(jen.If(jen.Id("aOptions").Dot("HeartbeatTimeout").Op("==").Lit(0)).BlockFunc(func(g *jen.Group) {
-								g.Add(jen.Id("aOptions").Dot("HeartbeatTimeout").Op("=").Id(getTimeObject(gf, "Duration")).CallFunc(func(g *jen.Group) {
-									g.Add(jen.Lit(*activityOptions.HeartbeatTimeout))
-								}).Op("*").Id(getTimeObject(gf, "Second")))
+								g.Add(jen.Id("aOptions").Dot("HeartbeatTimeout").Op("=").Add(duration(gf, activityOptions.HeartbeatTimeoutDuration)))
 							}))
 						}
 
 						if activityOptions.RetryPolicy != nil {
 							g.Add(jen.If(jen.Id("aOptions").Dot("RetryPolicy").Op("==").Nil())).BlockFunc(func(g *jen.Group) {
 								g.Add(jen.Id("aOptions").Dot("RetryPolicy").Op("=").Op("&").Id(getTemporalObject(gf, "RetryPolicy")).BlockFunc(func(g *jen.Group) {
-									if activityOptions.RetryPolicy.InitialInterval != nil {
-										g.Add(jen.Id("InitialInterval").Op(":").Id(getTimeObject(gf, "Duration")).CallFunc(func(g *jen.Group) {
-											g.Add(jen.Lit(*activityOptions.RetryPolicy.InitialInterval))
-										}).Op("*").Id(getTimeObject(gf, "Second")).Op(","))
+									if activityOptions.RetryPolicy.InitialIntervalDuration != nil {
+										g.Add(jen.Id("InitialInterval").Op(":").Add(duration(gf, activityOptions.RetryPolicy.InitialIntervalDuration)).Op(","))
 									}
-									if activityOptions.RetryPolicy.MaximumInterval != nil {
-										g.Add(jen.Id("MaximumInterval").Op(":").Id(getTimeObject(gf, "Duration")).CallFunc(func(g *jen.Group) {
-											g.Add(jen.Lit(*activityOptions.RetryPolicy.MaximumInterval))
-										}).Op("*").Id(getTimeObject(gf, "Second")).Op(","))
+									if activityOptions.RetryPolicy.MaximumIntervalDuration != nil {
+										g.Add(jen.Id("MaximumInterval").Op(":").Add(duration(gf, activityOptions.RetryPolicy.MaximumIntervalDuration)).Op(","))
 									}
 									if activityOptions.RetryPolicy.BackoffCoefficient != nil {
 										g.Add(jen.Id("BackoffCoefficient").Op(":").Float64().Call(jen.Lit(*activityOptions.RetryPolicy.BackoffCoefficient)).Op(","))
@@ -523,27 +509,21 @@ func Client(gf *protogen.GeneratedFile, service *protogen.Service, config *Confi
 							})
 						}
 
-						if activityOptions.ScheduleToCloseTimeout != nil {
+						if activityOptions.ScheduleToCloseTimeoutDuration != nil {
 							g.Add(jen.If(jen.Id("aOptions").Dot("ScheduleToCloseTimeout").Op("==").Lit(0)).BlockFunc(func(g *jen.Group) {
-								g.Add(jen.Id("aOptions").Dot("ScheduleToCloseTimeout").Op("=").Id(getTimeObject(gf, "Duration")).CallFunc(func(g *jen.Group) {
-									g.Add(jen.Lit(*activityOptions.ScheduleToCloseTimeout))
-								}).Op("*").Id(getTimeObject(gf, "Second")))
+								g.Add(jen.Id("aOptions").Dot("ScheduleToCloseTimeout").Op("=").Add(duration(gf, activityOptions.ScheduleToCloseTimeoutDuration)))
 							}))
 						}
 
-						if activityOptions.StartToCloseTimeout != nil {
+						if activityOptions.StartToCloseTimeoutDuration != nil {
 							g.Add(jen.If(jen.Id("aOptions").Dot("StartToCloseTimeout").Op("==").Lit(0)).BlockFunc(func(g *jen.Group) {
-								g.Add(jen.Id("aOptions").Dot("StartToCloseTimeout").Op("=").Id(getTimeObject(gf, "Duration")).CallFunc(func(g *jen.Group) {
-									g.Add(jen.Lit(*activityOptions.StartToCloseTimeout))
-								}).Op("*").Id(getTimeObject(gf, "Second")))
+								g.Add(jen.Id("aOptions").Dot("StartToCloseTimeout").Op("=").Add(duration(gf, activityOptions.StartToCloseTimeoutDuration)))
 							}))
 						}
 
-						if activityOptions.ScheduleToStartTimeout != nil {
+						if activityOptions.ScheduleToStartTimeoutDuration != nil {
 							g.Add(jen.If(jen.Id("aOptions").Dot("ScheduleToStartTimeout").Op("==").Lit(0)).BlockFunc(func(g *jen.Group) {
-								g.Add(jen.Id("aOptions").Dot("ScheduleToStartTimeout").Op("=").Id(getTimeObject(gf, "Duration")).CallFunc(func(g *jen.Group) {
-									g.Add(jen.Lit(*activityOptions.ScheduleToStartTimeout))
-								}).Op("*").Id(getTimeObject(gf, "Second")))
+								g.Add(jen.Id("aOptions").Dot("ScheduleToStartTimeout").Op("=").Add(duration(gf, activityOptions.ScheduleToStartTimeoutDuration)))
 							}))
 						}
 					} else {
@@ -598,7 +578,13 @@ func Client(gf *protogen.GeneratedFile, service *protogen.Service, config *Confi
 						g.Add(jen.Op("&").Id("resp"))
 					}))
 
-					g.Add(IfErrNilDouble)
+					if hasHeartbeatDetails(method) {
+						g.Add(jen.If(jen.Err().Op("!=").Nil()).Block(
+							jen.Return(jen.Nil(), jen.Id(getNewActivityErrorName(service, method)).Call(jen.Err())),
+						))
+					} else {
+						g.Add(IfErrNilDouble)
+					}
 
 					g.Add(jen.ReturnFunc(func(g *jen.Group) {
 						g.Add(jen.Id("resp"))
@@ -619,3 +605,102 @@ func Client(gf *protogen.GeneratedFile, service *protogen.Service, config *Confi
 
 	return nil
 }
+
+// startWorkflowOptionsDefaults adds the statements applying the proto defaults
+// to the `wOptions` client.StartWorkflowOptions variable, `req` being the
+// name of the workflow request variable
+func startWorkflowOptionsDefaults(gf *protogen.GeneratedFile, g *jen.Group, service *protogen.Service, method *protogen.Method, methName string, workflowOptions *temporalv1.WorkflowOptions, workflowID *jen.Statement, req string, config *Config) {
+	g.Add(jen.If(jen.Id("wOptions").Dot("TaskQueue").Op("==").Lit("")).BlockFunc(func(g *jen.Group) {
+		g.Add(jen.Id("wOptions").Dot("TaskQueue").Op("=").Id("c").Dot("taskQueue"))
+	}))
+	g.Add(jen.If(jen.Id("wOptions").Dot("TaskQueue").Op("==").Lit("")).BlockFunc(func(g *jen.Group) {
+		g.Add(jen.Id("wOptions").Dot("TaskQueue").Op("=").Id(fmt.Sprintf("Default%sTaskQueueName", service.GoName)))
+	}))
+
+	if workflowID != nil {
+		g.Add(jen.If(jen.Id("wOptions").Dot("ID").Op("==").Lit("")).Block(
+			jen.Id("wOptions").Dot("ID").Op("=").Add(workflowID.Clone()),
+		))
+	} else if config.GenWorkflowPrefix {
+		g.Add(
+			jen.If(jen.Id("wOptions").Dot("ID").Op("==").Lit("")).BlockFunc(func(g *jen.Group) {
+				g.Add(jen.Id("wOptions").Dot("ID").Op("=").Id(getFmtObject(gf, "Sprintf")).CallFunc(func(g *jen.Group) {
+					g.Add(jen.Lit("%s/%s"))
+					g.Add(jen.Lit(methName))
+					g.Add(jen.Id(getUUIDObject(gf, "NewString")).Parens(jen.Null()))
+				}))
+			},
+			),
+		)
+	}
+
+	if workflowOptions != nil {
+		if workflowOptions.WorkflowExecutionTimeoutDuration != nil {
+			g.Add(jen.If(jen.Id("wOptions").Dot("WorkflowExecutionTimeout").Op("==").Lit(0)).BlockFunc(func(g *jen.Group) {
+				g.Add(jen.Id("wOptions").Dot("WorkflowExecutionTimeout").Op("=").Add(duration(gf, workflowOptions.WorkflowExecutionTimeoutDuration)))
+			}))
+		}
+
+		if workflowOptions.WorkflowRunTimeoutDuration != nil {
+			g.Add(jen.If(jen.Id("wOptions").Dot("WorkflowRunTimeout").Op("==").Lit(0)).BlockFunc(func(g *jen.Group) {
+				g.Add(jen.Id("wOptions").Dot("WorkflowRunTimeout").Op("=").Add(duration(gf, workflowOptions.WorkflowRunTimeoutDuration)))
+			}))
+		}
+
+		if workflowOptions.WorkflowTaskTimeoutDuration != nil {
+			g.Add(jen.If(jen.Id("wOptions").Dot("WorkflowTaskTimeout").Op("==").Lit(0)).BlockFunc(func(g *jen.Group) {
+				g.Add(jen.Id("wOptions").Dot("WorkflowTaskTimeout").Op("=").Add(duration(gf, workflowOptions.WorkflowTaskTimeoutDuration)))
+			}))
+		}
+
+		if workflowOptions.RetryPolicy != nil {
+			g.Add(jen.If(jen.Id("wOptions").Dot("RetryPolicy").Op("==").Nil())).BlockFunc(func(g *jen.Group) {
+				g.Add(jen.Id("wOptions").Dot("RetryPolicy").Op("=").Op("&").Id(getTemporalObject(gf, "RetryPolicy")).BlockFunc(func(g *jen.Group) {
+					if workflowOptions.RetryPolicy.InitialIntervalDuration != nil {
+						g.Add(jen.Id("InitialInterval").Op(":").Add(duration(gf, workflowOptions.RetryPolicy.InitialIntervalDuration)).Op(","))
+					}
+					if workflowOptions.RetryPolicy.MaximumIntervalDuration != nil {
+						g.Add(jen.Id("MaximumInterval").Op(":").Add(duration(gf, workflowOptions.RetryPolicy.MaximumIntervalDuration)).Op(","))
+					}
+					if workflowOptions.RetryPolicy.BackoffCoefficient != nil {
+						g.Add(jen.Id("BackoffCoefficient").Op(":").Float64().Call(jen.Lit(*workflowOptions.RetryPolicy.BackoffCoefficient)).Op(","))
+					}
+					if workflowOptions.RetryPolicy.MaximumAttempts != nil {
+						g.Add(jen.Id("MaximumAttempts").Op(":").Lit(*workflowOptions.RetryPolicy.MaximumAttempts).Op(","))
+					}
+					if workflowOptions.RetryPolicy.NonRetryableErrorTypes != nil {
+						g.Add(jen.Id("NonRetryableErrorTypes").Op(":").Index(jen.Null()).String().Values(jen.ListFunc(func(g *jen.Group) {
+							for _, errType := range workflowOptions.RetryPolicy.NonRetryableErrorTypes {
+								g.Lit(errType)
+							}
+						})).Op(","))
+					}
+				}))
+			})
+		}
+	}
+
+	if workflowOptions.IdReusePolicy != temporalv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
+		g.Add(jen.If(jen.Id("wOptions").Dot("WorkflowIDReusePolicy").Op("==").Id(getTemporalEnumsObject(gf, "WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED"))).Block(
+			jen.Id("wOptions").Dot("WorkflowIDReusePolicy").Op("=").Id(getTemporalEnumsObject(gf, workflowOptions.IdReusePolicy.String())),
+		))
+	}
+
+	if workflowOptions.IdConflictPolicy != temporalv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_UNSPECIFIED {
+		g.Add(jen.If(jen.Id("wOptions").Dot("WorkflowIDConflictPolicy").Op("==").Id(getTemporalEnumsObject(gf, "WORKFLOW_ID_CONFLICT_POLICY_UNSPECIFIED"))).Block(
+			jen.Id("wOptions").Dot("WorkflowIDConflictPolicy").Op("=").Id(getTemporalEnumsObject(gf, workflowOptions.IdConflictPolicy.String())),
+		))
+	}
+
+	if workflowOptions.GetErrorWhenAlreadyStarted() {
+		g.Add(jen.Id("wOptions").Dot("WorkflowExecutionErrorWhenAlreadyStarted").Op("=").True())
+	}
+
+	if hasSearchAttributes(method) {
+		g.Add(searchAttributesDefault(gf, service, method, jen.Id("wOptions"), req))
+	}
+
+	if hasMemo(method) {
+		g.Add(memoDefault(service, method, jen.Id("wOptions"), req))
+	}
+}
@@ -0,0 +1,346 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func getClientInterceptorName(service *protogen.Service) string {
+	return fmt.Sprintf("%sClientInterceptor", service.GoName)
+}
+
+// hookParam is a parameter of a method of the client interceptors
+type hookParam struct {
+	name string
+	typ  *jen.Statement
+}
+
+// clientHook is a call of the client the interceptors can wrap, the hook of
+// the interceptors being Intercept<name>
+type clientHook struct {
+	name    string
+	doc     string
+	params  []hookParam
+	results []*jen.Statement
+	// rpc is the RPC the hook is generated for, the signal for the signal
+	// with start hooks
+	rpc *protogen.Method
+	// foreign is true for the signals, queries and updates of other services
+	// referenced by the workflows, the client only declaring their unexported
+	// implementation
+	foreign bool
+}
+
+// getImplName returns the name of the unexported method of the client doing
+// the call once the interceptors ran
+func (h *clientHook) getImplName() string {
+	return strings.ToLower(h.name[:1]) + h.name[1:]
+}
+
+// nextType returns the type of the function an interceptor calls to run the
+// rest of the chain
+func (h *clientHook) nextType() *jen.Statement {
+	return jen.Func().ParamsFunc(func(g *jen.Group) {
+		for _, p := range h.params {
+			g.Add(p.typ)
+		}
+	}).ParamsFunc(func(g *jen.Group) {
+		for _, r := range h.results {
+			g.Add(r)
+		}
+	})
+}
+
+// signature returns the parameters of the hook without next, its results
+// and the arguments passing its parameters along
+func (h *clientHook) signature() ([]jen.Code, []jen.Code, []jen.Code) {
+	params := make([]jen.Code, 0)
+	args := make([]jen.Code, 0)
+	for _, p := range h.params {
+		params = append(params, jen.Id(p.name).Add(p.typ))
+		args = append(args, jen.Id(p.name))
+	}
+
+	results := make([]jen.Code, 0)
+	for _, r := range h.results {
+		results = append(results, r)
+	}
+
+	return params, args, results
+}
+
+// chain returns the statements running the call through the interceptors of
+// the client `c`, the first interceptor installed being the outermost one.
+// The variables named after the parameters of the hook must be in scope. The
+// results of the call are returned, or assigned to `results` when set
+func (h *clientHook) chain(results ...jen.Code) []jen.Code {
+	params, args, resultTypes := h.signature()
+
+	last := jen.Return(jen.Id("next").Call(args...))
+	if len(results) > 0 {
+		last = jen.List(results...).Op(":=").Id("next").Call(args...)
+	}
+
+	return []jen.Code{
+		jen.Id("next").Op(":=").Id("c").Dot(h.getImplName()),
+		jen.For(
+			jen.Id("i").Op(":=").Len(jen.Id("c").Dot("interceptors")).Op("-").Lit(1),
+			jen.Id("i").Op(">=").Lit(0),
+			jen.Id("i").Op("--"),
+		).Block(
+			jen.List(jen.Id("interceptor"), jen.Id("inner")).Op(":=").List(jen.Id("c").Dot("interceptors").Index(jen.Id("i")), jen.Id("next")),
+			jen.Id("next").Op("=").Func().Params(params...).Params(resultTypes...).Block(
+				jen.Return(jen.Id("interceptor").Dot("Intercept"+h.name).Call(append(args, jen.Id("inner"))...)),
+			),
+		),
+		last,
+	}
+}
+
+// method returns the method of the client running the call through the
+// interceptors, for the hooks taking the same parameters as the method
+func (h *clientHook) method(clientName string) *jen.Statement {
+	params, _, results := h.signature()
+
+	return jen.Func().Parens(jen.Id("c").Op("*").Id(clientName)).Id(h.name).Params(params...).Params(results...).Block(h.chain()...)
+}
+
+// newClientHook returns the hook of a signal, query or update
+func newClientHook(gf *protogen.GeneratedFile, method *protogen.Method, t MethodType, foreign bool) *clientHook {
+	ctx := hookParam{"ctx", jen.Id(getContext(gf))}
+	req := hookParam{"req", jen.Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent))}
+	workflowID := hookParam{"workflowID", jen.String()}
+	runID := hookParam{"runID", jen.String()}
+
+	switch t {
+	case MethodTypeSignal:
+		return &clientHook{
+			name:    fmt.Sprintf("SendSignal%s", method.GoName),
+			doc:     fmt.Sprintf("sends the %s signal", method.GoName),
+			params:  []hookParam{ctx, workflowID, runID, req},
+			results: []*jen.Statement{jen.Error()},
+			rpc:     method,
+			foreign: foreign,
+		}
+	case MethodTypeQuery:
+		return &clientHook{
+			name:    fmt.Sprintf("Query%s", method.GoName),
+			doc:     fmt.Sprintf("sends the %s query", method.GoName),
+			params:  []hookParam{ctx, workflowID, runID, req},
+			results: []*jen.Statement{jen.Op("*").Id(gf.QualifiedGoIdent(method.Output.GoIdent)), jen.Error()},
+			rpc:     method,
+			foreign: foreign,
+		}
+	default:
+		return &clientHook{
+			name:    fmt.Sprintf("Update%s", method.GoName),
+			doc:     fmt.Sprintf("sends the %s update", method.GoName),
+			params:  []hookParam{ctx, workflowID, runID, req, {"stage", jen.Id(getTemporalClientObject(gf, "WorkflowUpdateStage"))}},
+			results: []*jen.Statement{jen.Id(getTemporalClientObject(gf, "WorkflowUpdateHandle")), jen.Error()},
+			rpc:     method,
+			foreign: foreign,
+		}
+	}
+}
+
+// getClientHooks returns the calls of the client of a service the
+// interceptors can wrap, including the signals, queries and updates of other
+// services its workflows reference
+func getClientHooks(gf *protogen.GeneratedFile, service *protogen.Service, config *Config) ([]*clientHook, error) {
+	hooks := make([]*clientHook, 0)
+	names := make(map[string]*clientHook)
+
+	add := func(hook *clientHook) error {
+		if other, ok := names[hook.name]; ok {
+			if other.rpc == hook.rpc {
+				return nil
+			}
+			return fmt.Errorf("%s and %s would generate the same client interceptor hooks for service %s", other.rpc.Desc.FullName(), hook.rpc.Desc.FullName(), service.Desc.FullName())
+		}
+
+		names[hook.name] = hook
+		hooks = append(hooks, hook)
+		return nil
+	}
+
+	for _, method := range service.Methods {
+		t, err := getMethodType(method)
+		if err != nil {
+			return nil, err
+		}
+
+		switch t {
+		case MethodTypeWorkflow:
+			err = add(&clientHook{
+				name: fmt.Sprintf("ExecuteWorkflow%s", method.GoName),
+				doc:  fmt.Sprintf("starts the %s workflow", method.GoName),
+				params: []hookParam{
+					{"ctx", jen.Id(getContext(gf))},
+					{"req", jen.Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent))},
+					{"opts", jen.Id(getTemporalClientObject(gf, "StartWorkflowOptions"))},
+				},
+				results: []*jen.Statement{jen.Id(getTemporalClientObject(gf, "WorkflowRun")), jen.Error()},
+				rpc:     method,
+			})
+		case MethodTypeSignal, MethodTypeQuery, MethodTypeUpdate:
+			err = add(newClientHook(gf, method, t, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, method := range service.Methods {
+		if t, _ := getMethodType(method); t != MethodTypeWorkflow {
+			continue
+		}
+
+		// the same options as the workflow objects
+		opts := getWorkflowOptions(method)
+		if opts == nil {
+			opts = getDefaultWorkflowOptions(service)
+		}
+
+		refs := []struct {
+			t     MethodType
+			names []string
+		}{
+			{MethodTypeSignal, opts.GetSignals()},
+			{MethodTypeQuery, opts.GetQueries()},
+			{MethodTypeUpdate, opts.GetUpdates()},
+		}
+		for _, r := range refs {
+			t := r.t
+			// report the clashes within a workflow the way the workflow objects do
+			if err := config.registry.checkMethodNames(service, method, r.names, t); err != nil {
+				return nil, err
+			}
+			for _, name := range r.names {
+				ref, err := config.registry.findMethod(service, name, t)
+				if err != nil {
+					return nil, fmt.Errorf("invalid workflow %s: %w", method.GoName, err)
+				}
+				if ref.Parent == service {
+					continue
+				}
+				if err := add(newClientHook(gf, ref, t, true)); err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		for _, name := range opts.GetSignals() {
+			sig, err := config.registry.findMethod(service, name, MethodTypeSignal)
+			if err != nil {
+				return nil, fmt.Errorf("invalid workflow %s: %w", method.GoName, err)
+			}
+
+			err = add(&clientHook{
+				name: fmt.Sprintf("SignalWithStart%s%s", method.GoName, sig.GoName),
+				doc:  fmt.Sprintf("sends the %s signal to the %s workflow, starting it if it is not running", sig.GoName, method.GoName),
+				params: []hookParam{
+					{"ctx", jen.Id(getContext(gf))},
+					{"sigReq", jen.Op("*").Id(gf.QualifiedGoIdent(sig.Input.GoIdent))},
+					{"wfReq", jen.Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent))},
+					{"opts", jen.Id(getTemporalClientObject(gf, "StartWorkflowOptions"))},
+				},
+				results: []*jen.Statement{jen.Id(getTemporalClientObject(gf, "WorkflowRun")), jen.Error()},
+				rpc:     sig,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return hooks, nil
+}
+
+// getClientHook returns the hook of a call of the client
+func getClientHook(gf *protogen.GeneratedFile, service *protogen.Service, config *Config, name string) (*clientHook, error) {
+	hooks, err := getClientHooks(gf, service, config)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, hook := range hooks {
+		if hook.name == name {
+			return hook, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no client hook %s for service %s", name, service.Desc.FullName())
+}
+
+// ClientInterceptors generates the typed interceptors of the client of a
+// service
+func ClientInterceptors(gf *protogen.GeneratedFile, service *protogen.Service, config *Config) error {
+	hooks, err := getClientHooks(gf, service, config)
+	if err != nil {
+		return err
+	}
+
+	clientName := getClientName(service)
+	interceptorName := getClientInterceptorName(service)
+	baseName := interceptorName + "Base"
+
+	interceptors := jen.Null()
+
+	interceptors.Comment(fmt.Sprintf("%s intercepts the calls of the %s, each hook gets the typed", interceptorName, clientName)).Line().
+		Comment("request and calls next to run the rest of the chain, up to temporal").Line().
+		Type().Id(interceptorName).InterfaceFunc(func(g *jen.Group) {
+		for _, hook := range hooks {
+			params, _, results := hook.signature()
+			g.Comment(fmt.Sprintf("Intercept%s intercepts the call that %s", hook.name, hook.doc))
+			g.Id("Intercept" + hook.name).Params(append(params, jen.Id("next").Add(hook.nextType()))...).Params(results...)
+		}
+	}).Line().Line()
+
+	interceptors.Comment(fmt.Sprintf("%s is a %s passing all the calls along,", baseName, interceptorName)).Line().
+		Comment("to be embedded by the interceptors only implementing some of the hooks").Line().
+		Type().Id(baseName).Struct().Line().Line()
+
+	for _, hook := range hooks {
+		params, args, results := hook.signature()
+		interceptors.Comment(fmt.Sprintf("Intercept%s calls next", hook.name)).Line().
+			Func().Parens(jen.Id(baseName)).Id("Intercept" + hook.name).Params(append(params, jen.Id("next").Add(hook.nextType()))...).Params(results...).Block(
+			jen.Return(jen.Id("next").Call(args...)),
+		).Line().Line()
+	}
+
+	// the signals, queries and updates of other services have no public method
+	// on the client, only the calls the workflow objects run through the chain
+	for _, hook := range hooks {
+		if !hook.foreign {
+			continue
+		}
+
+		var impl *jen.Statement
+		switch t, _ := getMethodType(hook.rpc); t {
+		case MethodTypeSignal:
+			impl, err = signalImpl(gf, service, hook, config)
+		case MethodTypeQuery:
+			impl, err = queryImpl(gf, service, hook)
+		default:
+			impl, err = updateImpl(gf, service, hook, config)
+		}
+		if err != nil {
+			return err
+		}
+
+		interceptors.Add(impl)
+	}
+
+	buf := bytes.NewBufferString("")
+	if err := interceptors.Render(buf); err != nil {
+		return err
+	}
+
+	gf.P(buf.String())
+
+	return nil
+}
@@ -1,7 +1,30 @@
 package generator
 
+const (
+	// ProtoConverterBinary makes the generated data converters encode the
+	// messages as binary protobuf
+	ProtoConverterBinary = "binary"
+	// ProtoConverterJSON makes the generated data converters encode the
+	// messages as protobuf JSON
+	ProtoConverterJSON = "json"
+)
+
 type Config struct {
 	GenWorkflowPrefix              bool
 	GenDocs                        bool
+	GenTestEnv                     bool
 	DefaultActivityScheduleToClose int
+	// ProtoConverter is the encoding of the generated data converters, either
+	// ProtoConverterBinary or ProtoConverterJSON. They are not generated
+	// when it is empty
+	ProtoConverter string
+	// Validation makes the generated code call the Validate method of the
+	// requests implementing it before sending them to temporal
+	Validation bool
+	// ValidationOnReceipt makes the workers validate the requests of the
+	// workflows and activities again before running them
+	ValidationOnReceipt bool
+
+	// registry of the files of the request, set when generating a file
+	registry *registry
 }
@@ -14,6 +14,7 @@ func ServiceConstants(gf *protogen.GeneratedFile, service *protogen.Service, cfg
 	activitiesNames := jen.Line().Comment("Activities names constants").Line().Line()
 	signalsNames := jen.Line().Comment("Signals names constants").Line().Line()
 	queriesNames := jen.Line().Comment("Queries names constants").Line().Line()
+	updatesNames := jen.Line().Comment("Updates names constants").Line().Line()
 
 	for _, method := range service.Methods {
 		t, err := getMethodType(method)
@@ -41,6 +42,9 @@ func ServiceConstants(gf *protogen.GeneratedFile, service *protogen.Service, cfg
 		case MethodTypeQuery:
 			queriesNames.Comment(fmt.Sprintf("Name of query %s", method.Desc.FullName())).Line().
 				Id(fmt.Sprintf("Query%s%sName", service.GoName, method.GoName)).Op("=").Lit(name).Line()
+		case MethodTypeUpdate:
+			updatesNames.Comment(fmt.Sprintf("Name of update %s", method.Desc.FullName())).Line().
+				Id(fmt.Sprintf("Update%s%sName", service.GoName, method.GoName)).Op("=").Lit(name).Line()
 		default:
 			return fmt.Errorf("invalid method type: %s", t)
 		}
@@ -53,7 +57,7 @@ func ServiceConstants(gf *protogen.GeneratedFile, service *protogen.Service, cfg
 		Id(fmt.Sprintf("Default%sActivityScheduleToCloseTimeout", service.GoName)).Op("=").Lit(cfg.DefaultActivityScheduleToClose).Line()
 
 	generated := jen.Const().Parens(
-		defaultTaskQueueName.Add(defaultActivityStartToClose.Add(workflowsNames).Add(activitiesNames).Add(signalsNames).Add(queriesNames)).Line().Line(),
+		defaultTaskQueueName.Add(defaultActivityStartToClose.Add(workflowsNames).Add(activitiesNames).Add(signalsNames).Add(queriesNames).Add(updatesNames)).Line().Line(),
 	)
 
 	buf := bytes.NewBufferString("")
@@ -0,0 +1,92 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func ServiceContinueAsNew(gf *protogen.GeneratedFile, service *protogen.Service) error {
+	continueAsNew := jen.Null()
+	found := false
+
+	for _, method := range service.Methods {
+		t, err := getMethodType(method)
+		if err != nil {
+			return err
+		}
+
+		if t != MethodTypeWorkflow {
+			continue
+		}
+
+		found = true
+
+		methName, err := getMethodRegisteredName(method)
+		if err != nil {
+			return err
+		}
+
+		workflowOptions := getMergedWorkflowOptions(service, method)
+
+		continueAsNew.Comment(fmt.Sprintf("New%sContinueAsNewError returns the error continuing the %s workflow as new with the given request", method.GoName, method.GoName)).Line().
+			Func().Id(fmt.Sprintf("New%sContinueAsNewError", method.GoName)).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Id("ctx").Id(getTemporalWorkflowObject(gf, "Context")))
+			g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent)))
+			g.Add(jen.Id("options").Op("...").Id(getTemporalWorkflowObject(gf, "ContinueAsNewErrorOptions")))
+		}).Error().BlockFunc(func(g *jen.Group) {
+			g.Add(jen.Var().Id("cOptions").Id(getTemporalWorkflowObject(gf, "ContinueAsNewErrorOptions")))
+			g.Add(jen.If(jen.Len(jen.Id("options")).Op(">").Lit(0).Block(
+				jen.Id("cOptions").Op("=").Id("options").Index(jen.Lit(0)),
+			)))
+
+			g.Add(jen.Return(jen.Id(getTemporalWorkflowObject(gf, "NewContinueAsNewErrorWithOptions")).Call(
+				jen.Id("ctx"),
+				jen.Id("cOptions"),
+				jen.Lit(methName),
+				jen.Id("req"),
+			)))
+		}).Line()
+
+		continueAsNew.Comment(fmt.Sprintf("%sContinueAsNewSuggested returns true when the current run of the %s workflow should continue as new,", method.GoName, method.GoName)).Line().
+			Comment("either because the server suggests it or because its history reached the configured thresholds").Line().
+			Func().Id(fmt.Sprintf("%sContinueAsNewSuggested", method.GoName)).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Id("ctx").Id(getTemporalWorkflowObject(gf, "Context")))
+		}).Bool().BlockFunc(func(g *jen.Group) {
+			g.Add(jen.Id("info").Op(":=").Id(getTemporalWorkflowObject(gf, "GetInfo")).Call(jen.Id("ctx")))
+
+			g.Add(jen.If(jen.Id("info").Dot("GetContinueAsNewSuggested").Call()).Block(
+				jen.Return(jen.True()),
+			))
+
+			if workflowOptions.ContinueAsNewHistoryLength != nil {
+				g.Add(jen.If(jen.Id("info").Dot("GetCurrentHistoryLength").Call().Op(">=").Lit(int(workflowOptions.GetContinueAsNewHistoryLength()))).Block(
+					jen.Return(jen.True()),
+				))
+			}
+
+			if workflowOptions.ContinueAsNewHistorySize != nil {
+				g.Add(jen.If(jen.Id("info").Dot("GetCurrentHistorySize").Call().Op(">=").Lit(int(workflowOptions.GetContinueAsNewHistorySize()))).Block(
+					jen.Return(jen.True()),
+				))
+			}
+
+			g.Add(jen.Return(jen.False()))
+		}).Line()
+	}
+
+	if !found {
+		return nil
+	}
+
+	buf := bytes.NewBufferString("")
+	if err := continueAsNew.Render(buf); err != nil {
+		return err
+	}
+
+	gf.P(buf.String())
+
+	return nil
+}
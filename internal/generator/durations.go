@@ -0,0 +1,210 @@
+package generator
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/dave/jennifer/jen"
+	temporalv1 "github.com/thomas-maurice/protoc-gen-go-tmprl/gen/temporal/v1"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// timeout is a timeout or interval option that can be set either in
+// seconds or as a google.protobuf.Duration
+type timeout struct {
+	name     string
+	seconds  *int32
+	duration *durationpb.Duration
+}
+
+func activityTimeouts(opts *temporalv1.ActivityOptions) []timeout {
+	return []timeout{
+		{"schedule_to_close_timeout", opts.ScheduleToCloseTimeout, opts.ScheduleToCloseTimeoutDuration},
+		{"start_to_close_timeout", opts.StartToCloseTimeout, opts.StartToCloseTimeoutDuration},
+		{"schedule_to_start_timeout", opts.ScheduleToStartTimeout, opts.ScheduleToStartTimeoutDuration},
+		{"heartbeat_timeout", opts.HeartbeatTimeout, opts.HeartbeatTimeoutDuration},
+		{"local_schedule_to_close_timeout", opts.LocalScheduleToCloseTimeout, opts.LocalScheduleToCloseTimeoutDuration},
+		{"local_start_to_close_timeout", opts.LocalStartToCloseTimeout, opts.LocalStartToCloseTimeoutDuration},
+	}
+}
+
+func workflowTimeouts(opts *temporalv1.WorkflowOptions) []timeout {
+	return []timeout{
+		{"workflow_execution_timeout", opts.WorkflowExecutionTimeout, opts.WorkflowExecutionTimeoutDuration},
+		{"workflow_run_timeout", opts.WorkflowRunTimeout, opts.WorkflowRunTimeoutDuration},
+		{"workflow_task_timeout", opts.WorkflowTaskTimeout, opts.WorkflowTaskTimeoutDuration},
+	}
+}
+
+func retryPolicyIntervals(rp *temporalv1.RetryPolicy) []timeout {
+	if rp == nil {
+		return nil
+	}
+
+	return []timeout{
+		{"retry_policy.initial_interval", rp.InitialInterval, rp.InitialIntervalDuration},
+		{"retry_policy.maximum_interval", rp.MaximumInterval, rp.MaximumIntervalDuration},
+	}
+}
+
+// checkTimeouts returns an error if a timeout is set in both forms or if its
+// duration is not valid
+func checkTimeouts(timeouts []timeout) error {
+	for _, t := range timeouts {
+		if t.seconds != nil && t.duration != nil {
+			return fmt.Errorf("%s is set both in seconds and as a duration", t.name)
+		}
+
+		if t.duration == nil {
+			continue
+		}
+
+		if err := t.duration.CheckValid(); err != nil {
+			return fmt.Errorf("invalid %s: %w", t.name, err)
+		}
+
+		if t.duration.AsDuration() < 0 {
+			return fmt.Errorf("invalid %s: negative duration", t.name)
+		}
+
+		// AsDuration saturates the durations a time.Duration cannot hold
+		if !proto.Equal(durationpb.New(t.duration.AsDuration()), t.duration) {
+			return fmt.Errorf("invalid %s: longer than %s", t.name, time.Duration(math.MaxInt64))
+		}
+	}
+
+	return nil
+}
+
+func checkActivityTimeouts(opts *temporalv1.ActivityOptions) error {
+	if opts == nil {
+		return nil
+	}
+
+	timeouts := activityTimeouts(opts)
+	timeouts = append(timeouts, retryPolicyIntervals(opts.GetRetryPolicy())...)
+	for _, t := range retryPolicyIntervals(opts.GetLocalRetryPolicy()) {
+		t.name = "local_" + t.name
+		timeouts = append(timeouts, t)
+	}
+
+	return checkTimeouts(timeouts)
+}
+
+func checkWorkflowTimeouts(opts *temporalv1.WorkflowOptions) error {
+	if opts == nil {
+		return nil
+	}
+
+	timeouts := workflowTimeouts(opts)
+	timeouts = append(timeouts, retryPolicyIntervals(opts.GetRetryPolicy())...)
+
+	return checkTimeouts(timeouts)
+}
+
+// checkServiceTimeouts validates the timeouts of a service and of its
+// methods as written in the proto file, before they get normalized
+func checkServiceTimeouts(service *protogen.Service) error {
+	svcOpts, _ := proto.GetExtension(service.Desc.Options(), temporalv1.E_Service).(*temporalv1.ServiceOptions)
+
+	if err := checkActivityTimeouts(svcOpts.GetDefaultActivityOptions()); err != nil {
+		return fmt.Errorf("invalid default activity options of %s: %w", service.Desc.FullName(), err)
+	}
+
+	if err := checkWorkflowTimeouts(svcOpts.GetDefaultWorkflowOptions()); err != nil {
+		return fmt.Errorf("invalid default workflow options of %s: %w", service.Desc.FullName(), err)
+	}
+
+	for _, method := range service.Methods {
+		act, _ := proto.GetExtension(method.Desc.Options(), temporalv1.E_Activity).(*temporalv1.ActivityOptions)
+		if err := checkActivityTimeouts(act); err != nil {
+			return fmt.Errorf("invalid options of %s: %w", method.Desc.FullName(), err)
+		}
+
+		wf, _ := proto.GetExtension(method.Desc.Options(), temporalv1.E_Workflow).(*temporalv1.WorkflowOptions)
+		if err := checkWorkflowTimeouts(wf); err != nil {
+			return fmt.Errorf("invalid options of %s: %w", method.Desc.FullName(), err)
+		}
+	}
+
+	return nil
+}
+
+// toDuration returns the duration form of a timeout, converting the
+// seconds if that is how it is set
+func toDuration(seconds *int32, duration *durationpb.Duration) *durationpb.Duration {
+	if duration == nil && seconds != nil {
+		return durationpb.New(time.Duration(*seconds) * time.Second)
+	}
+
+	return duration
+}
+
+// normalizeRetryPolicy returns a copy of the retry policy with its
+// intervals set as durations only
+func normalizeRetryPolicy(rp *temporalv1.RetryPolicy) *temporalv1.RetryPolicy {
+	if rp == nil {
+		return nil
+	}
+
+	rp = proto.Clone(rp).(*temporalv1.RetryPolicy)
+	rp.InitialIntervalDuration, rp.InitialInterval = toDuration(rp.InitialInterval, rp.InitialIntervalDuration), nil
+	rp.MaximumIntervalDuration, rp.MaximumInterval = toDuration(rp.MaximumInterval, rp.MaximumIntervalDuration), nil
+
+	return rp
+}
+
+// normalizeActivityOptions returns a copy of the options with the timeouts set
+// as durations only, so the generators only have one form to deal with
+func normalizeActivityOptions(opts *temporalv1.ActivityOptions) *temporalv1.ActivityOptions {
+	if opts == nil {
+		return nil
+	}
+
+	opts = proto.Clone(opts).(*temporalv1.ActivityOptions)
+	opts.ScheduleToCloseTimeoutDuration, opts.ScheduleToCloseTimeout = toDuration(opts.ScheduleToCloseTimeout, opts.ScheduleToCloseTimeoutDuration), nil
+	opts.StartToCloseTimeoutDuration, opts.StartToCloseTimeout = toDuration(opts.StartToCloseTimeout, opts.StartToCloseTimeoutDuration), nil
+	opts.ScheduleToStartTimeoutDuration, opts.ScheduleToStartTimeout = toDuration(opts.ScheduleToStartTimeout, opts.ScheduleToStartTimeoutDuration), nil
+	opts.HeartbeatTimeoutDuration, opts.HeartbeatTimeout = toDuration(opts.HeartbeatTimeout, opts.HeartbeatTimeoutDuration), nil
+	opts.LocalScheduleToCloseTimeoutDuration, opts.LocalScheduleToCloseTimeout = toDuration(opts.LocalScheduleToCloseTimeout, opts.LocalScheduleToCloseTimeoutDuration), nil
+	opts.LocalStartToCloseTimeoutDuration, opts.LocalStartToCloseTimeout = toDuration(opts.LocalStartToCloseTimeout, opts.LocalStartToCloseTimeoutDuration), nil
+	opts.RetryPolicy = normalizeRetryPolicy(opts.RetryPolicy)
+	opts.LocalRetryPolicy = normalizeRetryPolicy(opts.LocalRetryPolicy)
+
+	return opts
+}
+
+// normalizeWorkflowOptions returns a copy of the options with the timeouts set
+// as durations only, so the generators only have one form to deal with
+func normalizeWorkflowOptions(opts *temporalv1.WorkflowOptions) *temporalv1.WorkflowOptions {
+	if opts == nil {
+		return nil
+	}
+
+	opts = proto.Clone(opts).(*temporalv1.WorkflowOptions)
+	opts.WorkflowExecutionTimeoutDuration, opts.WorkflowExecutionTimeout = toDuration(opts.WorkflowExecutionTimeout, opts.WorkflowExecutionTimeoutDuration), nil
+	opts.WorkflowRunTimeoutDuration, opts.WorkflowRunTimeout = toDuration(opts.WorkflowRunTimeout, opts.WorkflowRunTimeoutDuration), nil
+	opts.WorkflowTaskTimeoutDuration, opts.WorkflowTaskTimeout = toDuration(opts.WorkflowTaskTimeout, opts.WorkflowTaskTimeoutDuration), nil
+	opts.RetryPolicy = normalizeRetryPolicy(opts.RetryPolicy)
+
+	return opts
+}
+
+// duration returns the exact time.Duration statement of a duration, in
+// seconds or milliseconds when it is a round number of them. The durations
+// too long for a time.Duration are refused by checkTimeouts beforehand
+func duration(gf *protogen.GeneratedFile, d *durationpb.Duration) *jen.Statement {
+	v := d.AsDuration()
+
+	switch {
+	case v%time.Second == 0:
+		return jen.Id(getTimeObject(gf, "Duration")).Call(jen.Lit(int(v / time.Second))).Op("*").Id(getTimeObject(gf, "Second"))
+	case v%time.Millisecond == 0:
+		return jen.Id(getTimeObject(gf, "Duration")).Call(jen.Lit(int(v / time.Millisecond))).Op("*").Id(getTimeObject(gf, "Millisecond"))
+	default:
+		return jen.Id(getTimeObject(gf, "Duration")).Call(jen.Lit(int(v)))
+	}
+}
@@ -0,0 +1,244 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/dave/jennifer/jen"
+	temporalv1 "github.com/thomas-maurice/protoc-gen-go-tmprl/gen/temporal/v1"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// getErrorValueType returns the error type of a value of an error enum
+func getErrorValueType(value *protogen.EnumValue) string {
+	return string(value.Desc.FullName())
+}
+
+// getErrorValueName returns the name of the error of an enum value, the value
+// in CamelCase without the prefix of its enum, `ERROR_CODE_NOT_FOUND` of the
+// `ErrorCode` enum becoming `ErrorCodeNotFound`
+func getErrorValueName(enum *protogen.Enum, value *protogen.EnumValue) string {
+	var prefix strings.Builder
+	for i, r := range enum.Desc.Name() {
+		if i > 0 && unicode.IsUpper(r) {
+			prefix.WriteRune('_')
+		}
+		prefix.WriteRune(unicode.ToUpper(r))
+	}
+	prefix.WriteRune('_')
+
+	name := strings.TrimPrefix(string(value.Desc.Name()), prefix.String())
+
+	parts := strings.Split(strings.ToLower(name), "_")
+	for i, part := range parts {
+		if part != "" {
+			parts[i] = strings.ToUpper(part[:1]) + part[1:]
+		}
+	}
+
+	return enum.GoIdent.GoName + strings.Join(parts, "")
+}
+
+// isRetryableErrorValue returns true if the value of an error enum is
+// annotated as retryable
+func isRetryableErrorValue(value *protogen.EnumValue) bool {
+	opts, _ := proto.GetExtension(value.Desc.Options(), temporalv1.E_Error).(*temporalv1.ErrorOptions)
+	return opts.GetRetryable()
+}
+
+// expandRetryPolicy returns the retry policy with the values of its error enum
+// added to the non retryable error types. When an enum is set, the error types
+// listed by hand must be either types of the enum or errors declared by the
+// service, so a typo does not silently make an error retryable
+func (r *registry) expandRetryPolicy(service *protogen.Service, rp *temporalv1.RetryPolicy) (*temporalv1.RetryPolicy, error) {
+	if rp.GetNonRetryableErrorEnum() == "" {
+		return rp, nil
+	}
+
+	enum, err := r.findEnum(service, rp.GetNonRetryableErrorEnum())
+	if err != nil {
+		return nil, fmt.Errorf("invalid non retryable error enum: %w", err)
+	}
+
+	known := make(map[string]bool)
+	for _, value := range enum.Values {
+		known[getErrorValueType(value)] = true
+	}
+
+	for _, method := range service.Methods {
+		errs, err := getMethodErrors(service, method)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range errs {
+			if message, err := r.findMessage(service, name); err == nil {
+				known[string(message.Desc.FullName())] = true
+			}
+		}
+	}
+
+	for _, errType := range rp.GetNonRetryableErrorTypes() {
+		if !known[errType] {
+			return nil, fmt.Errorf("unknown non retryable error type %q for service %s, it is neither a value of %s nor a declared error", errType, service.Desc.FullName(), enum.Desc.FullName())
+		}
+	}
+
+	expanded := proto.Clone(rp).(*temporalv1.RetryPolicy)
+	for _, value := range enum.Values {
+		if value.Desc.Number() == 0 || isRetryableErrorValue(value) {
+			continue
+		}
+
+		if !slices.Contains(expanded.NonRetryableErrorTypes, getErrorValueType(value)) {
+			expanded.NonRetryableErrorTypes = append(expanded.NonRetryableErrorTypes, getErrorValueType(value))
+		}
+	}
+
+	return expanded, nil
+}
+
+// getRetryPolicies returns all the retry policies set on a service
+func getRetryPolicies(service *protogen.Service) []*temporalv1.RetryPolicy {
+	policies := []*temporalv1.RetryPolicy{
+		getDefaultActivityOptions(service).GetRetryPolicy(),
+		getDefaultActivityOptions(service).GetLocalRetryPolicy(),
+		getDefaultWorkflowOptions(service).GetRetryPolicy(),
+	}
+
+	for _, method := range service.Methods {
+		policies = append(policies,
+			getActivityOptions(method).GetRetryPolicy(),
+			getActivityOptions(method).GetLocalRetryPolicy(),
+			getWorkflowOptions(method).GetRetryPolicy(),
+		)
+	}
+
+	return policies
+}
+
+// getErrorEnums returns the error enums used by the temporal services of a
+// file, each of them once
+func getErrorEnums(file *protogen.File, config *Config) ([]*protogen.Enum, error) {
+	enums := make([]*protogen.Enum, 0)
+	seen := make(map[protoreflect.FullName]bool)
+
+	for _, service := range file.Services {
+		if so, ok := proto.GetExtension(service.Desc.Options(), temporalv1.E_Service).(*temporalv1.ServiceOptions); !ok || so == nil {
+			continue
+		}
+
+		for _, rp := range getRetryPolicies(service) {
+			if rp.GetNonRetryableErrorEnum() == "" {
+				continue
+			}
+
+			// also validates the non retryable error types of the policy
+			if _, err := config.registry.expandRetryPolicy(service, rp); err != nil {
+				return nil, err
+			}
+
+			enum, err := config.registry.findEnum(service, rp.GetNonRetryableErrorEnum())
+			if err != nil {
+				return nil, err
+			}
+
+			if seen[enum.Desc.FullName()] {
+				continue
+			}
+
+			seen[enum.Desc.FullName()] = true
+			enums = append(enums, enum)
+		}
+	}
+
+	return enums, nil
+}
+
+// getDeclaredErrorEnums returns the error enums of a file whose constructors
+// it declares, the other ones being declared by another file of the same Go
+// package
+func getDeclaredErrorEnums(file *protogen.File, config *Config) ([]*protogen.Enum, error) {
+	enums, err := getErrorEnums(file, config)
+	if err != nil {
+		return nil, err
+	}
+
+	declared := make([]*protogen.Enum, 0)
+	for _, enum := range enums {
+		declaring, err := config.registry.declaringFile(file, enum.Desc, func(f *protogen.File) (bool, error) {
+			used, err := getErrorEnums(f, config)
+			return slices.Contains(used, enum), err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if declaring == file {
+			declared = append(declared, enum)
+		}
+	}
+
+	return declared, nil
+}
+
+// ErrorEnums generates a typed constructor for each value of the error enums
+// used by the retry policies of a file, once per Go package
+func ErrorEnums(gf *protogen.GeneratedFile, file *protogen.File, config *Config) error {
+	enums, err := getDeclaredErrorEnums(file, config)
+	if err != nil {
+		return err
+	}
+
+	if len(enums) == 0 {
+		return nil
+	}
+
+	errorEnums := jen.Null()
+
+	for _, enum := range enums {
+		for _, value := range enum.Values {
+			if value.Desc.Number() == 0 {
+				continue
+			}
+
+			name := getErrorValueName(enum, value)
+			errorType := name + "ErrorType"
+
+			retried := "is not retried"
+			if isRetryableErrorValue(value) {
+				retried = "is retried"
+			}
+
+			errorEnums.Comment(fmt.Sprintf("%s is the type of the %s errors", errorType, value.Desc.Name())).Line().
+				Const().Id(errorType).Op("=").Lit(getErrorValueType(value)).Line().Line()
+
+			errorEnums.Comment(fmt.Sprintf("New%sError returns an application error of the %s type", name, value.Desc.Name())).Line().
+				Comment(fmt.Sprintf("It %s by the retry policies using %s as non retryable error enum", retried, enum.Desc.Name())).Line().
+				Func().Id(fmt.Sprintf("New%sError", name)).ParamsFunc(func(g *jen.Group) {
+				g.Add(jen.Id("msg").String())
+				g.Add(jen.Id("details").Op("...").Interface())
+			}).Error().BlockFunc(func(g *jen.Group) {
+				g.Add(jen.Return(jen.Id(getTemporalObject(gf, "NewApplicationError")).Call(
+					jen.Id("msg"),
+					jen.Id(errorType),
+					jen.Id("details").Op("..."),
+				)))
+			}).Line().Line()
+		}
+	}
+
+	buf := bytes.NewBufferString("")
+	if err := errorEnums.Render(buf); err != nil {
+		return err
+	}
+
+	gf.P(buf.String())
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package generator
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	examplev1 "github.com/thomas-maurice/protoc-gen-go-tmprl/gen/example/v1"
+	commonv1 "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	"google.golang.org/protobuf/proto"
+)
+
+// The tests of this file run the code generated for the example protos in
+// gen/example, checking the behaviour the golden files cannot show. Run
+// `make gen` after changing the generator so they run the current output
+
+// TestExamplePayloadCodec checks the generated payload codec encrypts the
+// payloads of the sensitive messages and leaves the other payloads as they are
+func TestExamplePayloadCodec(t *testing.T) {
+	keys := examplev1.ExampleStaticKeyProvider{
+		ID:  "test",
+		Key: []byte("0123456789abcdef0123456789abcdef"),
+	}
+	codec := examplev1.NewExamplePayloadCodec(keys)
+
+	in := &examplev1.ThrowDiesRequest{Results: 3, Loop: true, PlayerName: "player"}
+	payload, err := converter.GetDefaultDataConverter().ToPayload(in)
+	if err != nil {
+		t.Fatalf("could not encode the message: %s", err)
+	}
+	encoded, err := codec.Encode([]*commonv1.Payload{payload})
+	if err != nil {
+		t.Fatalf("could not encrypt the payload: %s", err)
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		if enc := string(encoded[0].GetMetadata()[converter.MetadataEncoding]); enc != examplev1.ExampleEncryptedEncoding {
+			t.Errorf("payload encoded as %s instead of %s", enc, examplev1.ExampleEncryptedEncoding)
+		}
+		if id := string(encoded[0].GetMetadata()[examplev1.ExampleEncryptionKeyIDMetadata]); id != keys.ID {
+			t.Errorf("payload encrypted with the key %q instead of %q", id, keys.ID)
+		}
+		if bytes.Contains(encoded[0].GetData(), []byte(in.PlayerName)) {
+			t.Errorf("the payload is stored in clear text")
+		}
+		decoded, err := codec.Decode(encoded)
+		if err != nil {
+			t.Fatalf("could not decrypt the payload: %s", err)
+		}
+		if !proto.Equal(decoded[0], payload) {
+			t.Errorf("the payload changed during the round trip, got %v instead of %v", decoded[0], payload)
+		}
+	})
+
+	t.Run("tampered ciphertext", func(t *testing.T) {
+		tampered := proto.Clone(encoded[0]).(*commonv1.Payload)
+		tampered.Data[len(tampered.Data)-1] ^= 255
+		if _, err := codec.Decode([]*commonv1.Payload{tampered}); err == nil {
+			t.Errorf("a tampered payload was decrypted")
+		}
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		other := examplev1.NewExamplePayloadCodec(examplev1.ExampleStaticKeyProvider{
+			ID:  "other",
+			Key: keys.Key,
+		})
+		if _, err := other.Decode(encoded); err == nil {
+			t.Errorf("a payload encrypted with an unknown key was decrypted")
+		}
+	})
+
+	t.Run("non sensitive payload", func(t *testing.T) {
+		plain, err := converter.GetDefaultDataConverter().ToPayload(&examplev1.ThrowUntilValueRequest{Value: 3})
+		if err != nil {
+			t.Fatalf("could not encode the message: %s", err)
+		}
+		encoded, err := codec.Encode([]*commonv1.Payload{plain})
+		if err != nil {
+			t.Fatalf("could not encode the payload: %s", err)
+		}
+		if !proto.Equal(encoded[0], plain) {
+			t.Errorf("a non sensitive payload was changed, got %v instead of %v", encoded[0], plain)
+		}
+		decoded, err := codec.Decode(encoded)
+		if err != nil {
+			t.Fatalf("could not decode the payload: %s", err)
+		}
+		if !proto.Equal(decoded[0], plain) {
+			t.Errorf("a non sensitive payload was changed, got %v instead of %v", decoded[0], plain)
+		}
+	})
+
+	t.Run("proto data converter", func(t *testing.T) {
+		dataConverter := examplev1.NewDieRollDataConverter(codec)
+		encoded, err := dataConverter.ToPayload(in)
+		if err != nil {
+			t.Fatalf("could not encode the message: %s", err)
+		}
+		if enc := string(encoded.GetMetadata()[converter.MetadataEncoding]); enc != examplev1.ExampleEncryptedEncoding {
+			t.Errorf("message encoded as %s instead of %s", enc, examplev1.ExampleEncryptedEncoding)
+		}
+		var out *examplev1.ThrowDiesRequest
+		if err := dataConverter.FromPayload(encoded, &out); err != nil {
+			t.Fatalf("could not decode the message: %s", err)
+		}
+		if !proto.Equal(in, out) {
+			t.Errorf("the message changed during the round trip, got %v instead of %v", out, in)
+		}
+	})
+}
+
+// TestExampleApplicationErrors checks the details of a generated application
+// error can be extracted from the errors of the workflows failing with it,
+// directly or through an activity or a child workflow
+func TestExampleApplicationErrors(t *testing.T) {
+	details := &examplev1.InvalidTargetValue{Value: 7}
+
+	failing := func(ctx workflow.Context, from string) error {
+		switch from {
+		case "activity":
+			ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{StartToCloseTimeout: time.Minute})
+			return workflow.ExecuteActivity(ctx, "Fail").Get(ctx, nil)
+		case "child workflow":
+			return workflow.ExecuteChildWorkflow(ctx, "Failing", "workflow").Get(ctx, nil)
+		}
+		return examplev1.NewInvalidTargetValueError("failed", details, true)
+	}
+	fail := func(ctx context.Context) error {
+		return examplev1.NewInvalidTargetValueError("failed", details, true)
+	}
+
+	for _, from := range []string{"workflow", "activity", "child workflow"} {
+		t.Run(from, func(t *testing.T) {
+			env := (&testsuite.WorkflowTestSuite{}).NewTestWorkflowEnvironment()
+			env.RegisterWorkflowWithOptions(failing, workflow.RegisterOptions{Name: "Failing"})
+			env.RegisterActivityWithOptions(fail, activity.RegisterOptions{Name: "Fail"})
+			env.ExecuteWorkflow("Failing", from)
+
+			out, ok := examplev1.AsInvalidTargetValueError(env.GetWorkflowError())
+			if !ok {
+				t.Fatalf("no InvalidTargetValue details found in %v", env.GetWorkflowError())
+			}
+			if !proto.Equal(details, out) {
+				t.Errorf("got the details %v instead of %v", out, details)
+			}
+		})
+	}
+
+	t.Run("other error", func(t *testing.T) {
+		if _, ok := examplev1.AsInvalidTargetValueError(temporal.NewApplicationError("failed", "other")); ok {
+			t.Errorf("InvalidTargetValue details found in an application error of another type")
+		}
+	})
+}
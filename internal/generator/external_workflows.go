@@ -0,0 +1,116 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func getExternalWorkflowObjectName(service *protogen.Service, method *protogen.Method) string {
+	return fmt.Sprintf("External%s%sExecution", service.GoName, method.GoName)
+}
+
+func ServiceExternalWorkflows(gf *protogen.GeneratedFile, service *protogen.Service, config *Config) error {
+	externals := jen.Null()
+	found := false
+
+	for _, method := range service.Methods {
+		t, err := getMethodType(method)
+		if err != nil {
+			return err
+		}
+
+		if t != MethodTypeWorkflow {
+			continue
+		}
+
+		found = true
+		externalName := getExternalWorkflowObjectName(service, method)
+
+		workflowOptions := getWorkflowOptions(method)
+		if workflowOptions == nil {
+			workflowOptions = getDefaultWorkflowOptions(service)
+		}
+
+		externals.Comment(fmt.Sprintf("%s is a handle to a %s workflow execution, to be used from another workflow", externalName, method.GoName)).Line().
+			Type().Id(externalName).StructFunc(func(g *jen.Group) {
+			g.Add(jen.Id("workflowId").String())
+			g.Add(jen.Id("runId").String())
+		}).Line()
+
+		externals.Comment(fmt.Sprintf("GetExternal%s returns a handle to a running %s workflow, an empty `runID` targets the current run", method.GoName, method.GoName)).Line().
+			Comment("This is called within a workflow exclusively").Line().
+			Func().Id(fmt.Sprintf("GetExternal%s", method.GoName)).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Id("ctx").Id(getTemporalWorkflowObject(gf, "Context")))
+			g.Add(jen.Id("workflowID").String())
+			g.Add(jen.Id("runID").String())
+		}).Op("*").Id(externalName).BlockFunc(func(g *jen.Group) {
+			g.Add(jen.Return(jen.Op("&").Id(externalName).Values(jen.Dict{
+				jen.Id("workflowId"): jen.Id("workflowID"),
+				jen.Id("runId"):      jen.Id("runID"),
+			})))
+		}).Line()
+
+		externals.Comment("WorkflowID returns the ID of the workflow").Line().
+			Func().Parens(jen.Id("w").Op("*").Id(externalName)).Id("WorkflowID").Params().String().Block(
+			jen.Return(jen.Id("w").Dot("workflowId")),
+		).Line()
+
+		externals.Comment("RunID returns the run ID of the workflow").Line().
+			Func().Parens(jen.Id("w").Op("*").Id(externalName)).Id("RunID").Params().String().Block(
+			jen.Return(jen.Id("w").Dot("runId")),
+		).Line()
+
+		externals.Comment("Cancel requests the cancellation of the workflow and waits for the request to be delivered").Line().
+			Func().Parens(jen.Id("w").Op("*").Id(externalName)).Id("Cancel").ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Id("ctx").Id(getTemporalWorkflowObject(gf, "Context")))
+		}).Error().BlockFunc(func(g *jen.Group) {
+			g.Add(jen.Return(jen.Id(getTemporalWorkflowObject(gf, "RequestCancelExternalWorkflow")).Call(
+				jen.Id("ctx"),
+				jen.Id("w").Dot("workflowId"),
+				jen.Id("w").Dot("runId"),
+			).Dot("Get").Call(jen.Id("ctx"), jen.Nil())))
+		}).Line()
+
+		for _, sig := range workflowOptions.GetSignals() {
+			meth, err := config.registry.findMethod(service, sig, MethodTypeSignal)
+			if err != nil {
+				return fmt.Errorf("invalid workflow %s: %w", method.GoName, err)
+			}
+
+			sigName, err := getMethodRegisteredName(meth)
+			if err != nil {
+				return err
+			}
+
+			externals.Comment(fmt.Sprintf("Signal%s sends the %s signal to the workflow and waits for it to be delivered", meth.GoName, meth.GoName)).Line().
+				Func().Parens(jen.Id("w").Op("*").Id(externalName)).Id("Signal" + meth.GoName).ParamsFunc(func(g *jen.Group) {
+				g.Add(jen.Id("ctx").Id(getTemporalWorkflowObject(gf, "Context")))
+				g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(meth.Input.GoIdent)))
+			}).Error().BlockFunc(func(g *jen.Group) {
+				g.Add(jen.Return(jen.Id(getTemporalWorkflowObject(gf, "SignalExternalWorkflow")).Call(
+					jen.Id("ctx"),
+					jen.Id("w").Dot("workflowId"),
+					jen.Id("w").Dot("runId"),
+					jen.Lit(sigName),
+					jen.Id("req"),
+				).Dot("Get").Call(jen.Id("ctx"), jen.Nil())))
+			}).Line()
+		}
+	}
+
+	if !found {
+		return nil
+	}
+
+	buf := bytes.NewBufferString("")
+	if err := externals.Render(buf); err != nil {
+		return err
+	}
+
+	gf.P(buf.String())
+
+	return nil
+}
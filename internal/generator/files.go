@@ -0,0 +1,305 @@
+package generator
+
+import (
+	"path"
+
+	temporalv1 "github.com/thomas-maurice/protoc-gen-go-tmprl/gen/temporal/v1"
+	"github.com/thomas-maurice/protoc-gen-go-tmprl/internal/version"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+)
+
+// generateHeader writes the header and package clause of a generated go file
+func generateHeader(gen *protogen.GeneratedFile, file *protogen.File) {
+	generatePackageHeader(gen, file, file.GoPackageName)
+}
+
+// generatePackageHeader writes the header of a generated go file belonging to
+// the `pkg` package
+func generatePackageHeader(gen *protogen.GeneratedFile, file *protogen.File, pkg protogen.GoPackageName) {
+	gen.P("// Code generated by protoc-gen-go-tmprl. DO NOT EDIT.")
+	gen.P("//")
+	gen.P("// version:")
+	gen.P("//   protoc-gen-go-tmprl version: " + version.Version)
+	gen.P("//   protoc-gen-go-tmprl commit: " + version.Commit)
+	gen.P("//")
+	gen.P("// source file: " + file.Proto.GetName())
+	gen.P()
+	gen.P("package ", pkg)
+	gen.P()
+}
+
+// isTemporalFile returns true if a file defines temporal services, a
+// _tmprl.pb.go file being generated for it
+func isTemporalFile(file *protogen.File) bool {
+	for _, s := range file.Services {
+		if so, ok := proto.GetExtension(s.Desc.Options(), temporalv1.E_Service).(*temporalv1.ServiceOptions); ok && so != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GenerateFile generates the client, worker and workflow code of a proto file
+func GenerateFile(plugin *protogen.Plugin, file *protogen.File, config *Config) *protogen.GeneratedFile {
+	filename := file.GeneratedFilenamePrefix + "_tmprl.pb.go"
+
+	if !isTemporalFile(file) {
+		return nil
+	}
+
+	config = withRegistry(plugin, config)
+
+	gen := plugin.NewGeneratedFile(filename, file.GoImportPath)
+	generateHeader(gen, file)
+
+	for _, s := range file.Services {
+		if so, ok := proto.GetExtension(s.Desc.Options(), temporalv1.E_Service).(*temporalv1.ServiceOptions); !ok || so == nil {
+			// not a temporal service if the `temporal.v1.service` option is not set
+			continue
+		}
+
+		err := checkServiceTimeouts(s)
+		if err != nil {
+			plugin.Error(err)
+		}
+
+		err = ServiceConstants(gen, s, config)
+		if err != nil {
+			plugin.Error(err)
+		}
+
+		err = UnimplementedServiceInterface(gen, s)
+		if err != nil {
+			plugin.Error(err)
+		}
+
+		err = Worker(gen, s, config)
+		if err != nil {
+			plugin.Error(err)
+		}
+
+		err = ServiceMiddleware(gen, s)
+		if err != nil {
+			plugin.Error(err)
+		}
+
+		err = Client(gen, s, config)
+		if err != nil {
+			plugin.Error(err)
+		}
+
+		err = ClientInterceptors(gen, s, config)
+		if err != nil {
+			plugin.Error(err)
+		}
+
+		err = ServiceValidation(gen, s, config)
+		if err != nil {
+			plugin.Error(err)
+		}
+
+		err = ServiceProtoConverter(gen, s, config)
+		if err != nil {
+			plugin.Error(err)
+		}
+
+		err = ServiceLocalActivities(gen, s, config)
+		if err != nil {
+			plugin.Error(err)
+		}
+
+		err = ServiceHeartbeats(gen, s, config)
+		if err != nil {
+			plugin.Error(err)
+		}
+
+		err = WorkflowObjects(gen, s, config)
+		if err != nil {
+			plugin.Error(err)
+		}
+
+		err = ServiceExternalWorkflows(gen, s, config)
+		if err != nil {
+			plugin.Error(err)
+		}
+
+		err = ServiceSignals(gen, s, config)
+		if err != nil {
+			plugin.Error(err)
+		}
+
+		err = ServiceSignalsWithStart(gen, s, config)
+		if err != nil {
+			plugin.Error(err)
+		}
+
+		err = ServiceQueries(gen, s, config)
+		if err != nil {
+			plugin.Error(err)
+		}
+
+		err = ServiceQueryHandlers(gen, s, config)
+		if err != nil {
+			plugin.Error(err)
+		}
+
+		err = ServiceUpdates(gen, s, config)
+		if err != nil {
+			plugin.Error(err)
+		}
+
+		err = ServiceSchedules(gen, s, config)
+		if err != nil {
+			plugin.Error(err)
+		}
+
+		err = ServiceSearchAttributes(gen, s)
+		if err != nil {
+			plugin.Error(err)
+		}
+
+		err = ServiceMemos(gen, s)
+		if err != nil {
+			plugin.Error(err)
+		}
+
+		err = ServiceContinueAsNew(gen, s)
+		if err != nil {
+			plugin.Error(err)
+		}
+	}
+
+	err := ApplicationErrors(gen, file, config)
+	if err != nil {
+		plugin.Error(err)
+	}
+
+	err = ErrorEnums(gen, file, config)
+	if err != nil {
+		plugin.Error(err)
+	}
+
+	err = SensitivePayloadCodec(gen, file)
+	if err != nil {
+		plugin.Error(err)
+	}
+
+	return gen
+}
+
+// GenerateTestEnv generates the typed test environments of a proto file
+func GenerateTestEnv(plugin *protogen.Plugin, file *protogen.File, config *Config) *protogen.GeneratedFile {
+	// the test environment lives in its own package so the code using the
+	// generated package does not import the test dependencies
+	pkg := getTestEnvPackageName(file)
+	filename := path.Join(path.Dir(file.GeneratedFilenamePrefix), string(pkg), path.Base(file.GeneratedFilenamePrefix)+"_tmprl_testenv.pb.go")
+
+	needsGenerate := false
+	for _, s := range file.Services {
+		if so, ok := proto.GetExtension(s.Desc.Options(), temporalv1.E_Service).(*temporalv1.ServiceOptions); ok && so != nil {
+			needsGenerate = true
+		}
+	}
+
+	if !needsGenerate {
+		return nil
+	}
+
+	config = withRegistry(plugin, config)
+
+	gen := plugin.NewGeneratedFile(filename, getTestEnvImportPath(file))
+	generatePackageHeader(gen, file, pkg)
+
+	for _, s := range file.Services {
+		if so, ok := proto.GetExtension(s.Desc.Options(), temporalv1.E_Service).(*temporalv1.ServiceOptions); !ok || so == nil {
+			// not a temporal service if the `temporal.v1.service` option is not set
+			continue
+		}
+
+		err := TestEnv(gen, file, s, config)
+		if err != nil {
+			plugin.Error(err)
+		}
+	}
+
+	return gen
+}
+
+// GenerateConverterTest generates the round trip tests of the data converters
+// of a proto file
+func GenerateConverterTest(plugin *protogen.Plugin, file *protogen.File, config *Config) *protogen.GeneratedFile {
+	filename := file.GeneratedFilenamePrefix + "_tmprl_converter_test.go"
+
+	needsGenerate := false
+	for _, s := range file.Services {
+		if so, ok := proto.GetExtension(s.Desc.Options(), temporalv1.E_Service).(*temporalv1.ServiceOptions); ok && so != nil {
+			needsGenerate = true
+		}
+	}
+
+	if !needsGenerate {
+		return nil
+	}
+
+	gen := plugin.NewGeneratedFile(filename, file.GoImportPath)
+	generateHeader(gen, file)
+
+	for _, s := range file.Services {
+		if so, ok := proto.GetExtension(s.Desc.Options(), temporalv1.E_Service).(*temporalv1.ServiceOptions); !ok || so == nil {
+			// not a temporal service if the `temporal.v1.service` option is not set
+			continue
+		}
+
+		err := ServiceConverterTest(gen, s, config)
+		if err != nil {
+			plugin.Error(err)
+		}
+	}
+
+	return gen
+}
+
+// GenerateReadme generates the markdown documentation of a proto file
+func GenerateReadme(plugin *protogen.Plugin, file *protogen.File, config *Config) *protogen.GeneratedFile {
+	filename := file.GeneratedFilenamePrefix + "_tmprl_doc.md"
+
+	needsGenerate := false
+	for _, s := range file.Services {
+		if so, ok := proto.GetExtension(s.Desc.Options(), temporalv1.E_Service).(*temporalv1.ServiceOptions); ok && so != nil {
+			needsGenerate = true
+		}
+	}
+
+	if !needsGenerate {
+		return nil
+	}
+
+	gen := plugin.NewGeneratedFile(filename, file.GoImportPath)
+	gen.P(`<a id="top"></a>`)
+	gen.P("# Services")
+	for _, s := range file.Services {
+		if so, ok := proto.GetExtension(s.Desc.Options(), temporalv1.E_Service).(*temporalv1.ServiceOptions); !ok || so == nil {
+			// not a temporal service if the `temporal.v1.service` option is not set
+			continue
+		}
+
+		err := ReadmeService(gen, s, config)
+		if err != nil {
+			plugin.Error(err)
+		}
+	}
+
+	gen.P("# Messages")
+	for _, m := range file.Messages {
+		err := ReadmeMessage(gen, m, config)
+		if err != nil {
+			plugin.Error(err)
+		}
+	}
+
+	gen.P("\n\n[Back to top](#top)")
+
+	return gen
+}
@@ -0,0 +1,1325 @@
+package generator
+
+import (
+	"time"
+
+	temporalv1 "github.com/thomas-maurice/protoc-gen-go-tmprl/gen/temporal/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// fixture is an in-memory proto file the generator is run against
+type fixture struct {
+	name   string
+	config *Config
+	file   *descriptorpb.FileDescriptorProto
+	// deps are the files of other packages the fixture imports
+	deps []*descriptorpb.FileDescriptorProto
+	// siblings are other files of the fixture package, generated along with it
+	siblings []*descriptorpb.FileDescriptorProto
+	// err is a part of the error an invalid fixture must be refused with
+	err string
+}
+
+func testConfig() *Config {
+	return &Config{
+		DefaultActivityScheduleToClose: 3600 * 24,
+	}
+}
+
+func fixtureFile(name string, services []*descriptorpb.ServiceDescriptorProto, messages ...*descriptorpb.DescriptorProto) *descriptorpb.FileDescriptorProto {
+	return &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("fixtures/v1/" + name + ".proto"),
+		Package: proto.String("fixtures.v1"),
+		Syntax:  proto.String("proto3"),
+		Dependency: []string{
+			"google/protobuf/empty.proto",
+			"temporal/v1/temporal.proto",
+		},
+		Options: &descriptorpb.FileOptions{
+			GoPackage: proto.String("example.com/fixtures/v1;fixturesv1"),
+		},
+		Service:     services,
+		MessageType: messages,
+	}
+}
+
+func service(name string, opts *temporalv1.ServiceOptions, methods ...*descriptorpb.MethodDescriptorProto) *descriptorpb.ServiceDescriptorProto {
+	svc := &descriptorpb.ServiceDescriptorProto{
+		Name:   proto.String(name),
+		Method: methods,
+	}
+
+	if opts != nil {
+		svc.Options = &descriptorpb.ServiceOptions{}
+		proto.SetExtension(svc.Options, temporalv1.E_Service, opts)
+	}
+
+	return svc
+}
+
+func rpc(name string, input string, output string, ext protoreflect.ExtensionType, opts proto.Message) *descriptorpb.MethodDescriptorProto {
+	meth := &descriptorpb.MethodDescriptorProto{
+		Name:       proto.String(name),
+		InputType:  proto.String(input),
+		OutputType: proto.String(output),
+	}
+
+	if ext != nil {
+		meth.Options = &descriptorpb.MethodOptions{}
+		proto.SetExtension(meth.Options, ext, opts)
+	}
+
+	return meth
+}
+
+func message(name string, fields ...*descriptorpb.FieldDescriptorProto) *descriptorpb.DescriptorProto {
+	for i, f := range fields {
+		f.Number = proto.Int32(int32(i + 1))
+		f.JsonName = proto.String(f.GetName())
+	}
+
+	return &descriptorpb.DescriptorProto{
+		Name:  proto.String(name),
+		Field: fields,
+	}
+}
+
+func field(name string, kind descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
+	return &descriptorpb.FieldDescriptorProto{
+		Name:  proto.String(name),
+		Type:  kind.Enum(),
+		Label: descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+	}
+}
+
+// messageField returns a field of type message or enum `typeName`
+func messageField(name string, kind descriptorpb.FieldDescriptorProto_Type, typeName string) *descriptorpb.FieldDescriptorProto {
+	f := field(name, kind)
+	f.TypeName = proto.String(typeName)
+	return f
+}
+
+func repeated(f *descriptorpb.FieldDescriptorProto) *descriptorpb.FieldDescriptorProto {
+	f.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	return f
+}
+
+func indexed(f *descriptorpb.FieldDescriptorProto, opts *temporalv1.SearchAttributeOptions) *descriptorpb.FieldDescriptorProto {
+	f.Options = &descriptorpb.FieldOptions{}
+	proto.SetExtension(f.Options, temporalv1.E_SearchAttribute, opts)
+	return f
+}
+
+func memo(f *descriptorpb.FieldDescriptorProto, opts *temporalv1.MemoOptions) *descriptorpb.FieldDescriptorProto {
+	f.Options = &descriptorpb.FieldOptions{}
+	proto.SetExtension(f.Options, temporalv1.E_Memo, opts)
+	return f
+}
+
+func sensitive(f *descriptorpb.FieldDescriptorProto) *descriptorpb.FieldDescriptorProto {
+	if f.Options == nil {
+		f.Options = &descriptorpb.FieldOptions{}
+	}
+	proto.SetExtension(f.Options, temporalv1.E_Sensitive, true)
+	return f
+}
+
+// withTimestamp adds the google.protobuf.Timestamp dependency to a fixture file
+func withTimestamp(file *descriptorpb.FileDescriptorProto) *descriptorpb.FileDescriptorProto {
+	file.Dependency = append(file.Dependency, "google/protobuf/timestamp.proto")
+	return file
+}
+
+// controlFile is a file of another package defining shared signals and queries
+func controlFile() *descriptorpb.FileDescriptorProto {
+	return &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("common/v1/control.proto"),
+		Package: proto.String("common.v1"),
+		Syntax:  proto.String("proto3"),
+		Dependency: []string{
+			"google/protobuf/empty.proto",
+			"temporal/v1/temporal.proto",
+		},
+		Options: &descriptorpb.FileOptions{
+			GoPackage: proto.String("example.com/common/v1;commonv1"),
+		},
+		Service: []*descriptorpb.ServiceDescriptorProto{
+			service("Control", &temporalv1.ServiceOptions{},
+				rpc("Pause", ".common.v1.PauseRequest", empty, temporalv1.E_Signal, &temporalv1.SignalOptions{}),
+				rpc("Resume", empty, empty, temporalv1.E_Signal, &temporalv1.SignalOptions{Name: "resume"}),
+				rpc("State", empty, ".common.v1.StateResponse", temporalv1.E_Query, &temporalv1.QueryOptions{}),
+			),
+		},
+		MessageType: []*descriptorpb.DescriptorProto{
+			message("PauseRequest", field("reason", descriptorpb.FieldDescriptorProto_TYPE_STRING)),
+			message("StateResponse", field("paused", descriptorpb.FieldDescriptorProto_TYPE_BOOL)),
+		},
+	}
+}
+
+// withControl adds the control file to the dependencies of a fixture file
+func withControl(file *descriptorpb.FileDescriptorProto) *descriptorpb.FileDescriptorProto {
+	file.Dependency = append(file.Dependency, "common/v1/control.proto")
+	return file
+}
+
+// sharedErrorsFiles returns files of the fixtures package using the same errors
+// as the shared_package_errors fixture, one of them defining a shared error and
+// the other one the messages and enums of the package without any service
+func sharedErrorsFiles() []*descriptorpb.FileDescriptorProto {
+	return []*descriptorpb.FileDescriptorProto{
+		withEnums(fixtureFile("errors", nil,
+			message("Shared", field("reason", descriptorpb.FieldDescriptorProto_TYPE_STRING)),
+		),
+			enum("Code",
+				enumValue("CODE_UNSPECIFIED", nil),
+				enumValue("CODE_BAD", nil),
+			),
+		),
+		fixtureFile("chargebacks", []*descriptorpb.ServiceDescriptorProto{
+			service("Chargebacks", &temporalv1.ServiceOptions{},
+				rpc("Dispute", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Errors: []string{"Shared", "Local"},
+					RetryPolicy: &temporalv1.RetryPolicy{
+						NonRetryableErrorEnum: "Code",
+					},
+				}),
+			),
+		},
+			message("Local", field("reason", descriptorpb.FieldDescriptorProto_TYPE_STRING)),
+		),
+	}
+}
+
+// withEnums adds top level enums to a fixture file
+func withEnums(file *descriptorpb.FileDescriptorProto, enums ...*descriptorpb.EnumDescriptorProto) *descriptorpb.FileDescriptorProto {
+	file.EnumType = append(file.EnumType, enums...)
+	return file
+}
+
+func enum(name string, values ...*descriptorpb.EnumValueDescriptorProto) *descriptorpb.EnumDescriptorProto {
+	for i, v := range values {
+		v.Number = proto.Int32(int32(i))
+	}
+
+	return &descriptorpb.EnumDescriptorProto{
+		Name:  proto.String(name),
+		Value: values,
+	}
+}
+
+func enumValue(name string, opts *temporalv1.ErrorOptions) *descriptorpb.EnumValueDescriptorProto {
+	value := &descriptorpb.EnumValueDescriptorProto{
+		Name: proto.String(name),
+	}
+
+	if opts != nil {
+		value.Options = &descriptorpb.EnumValueOptions{}
+		proto.SetExtension(value.Options, temporalv1.E_Error, opts)
+	}
+
+	return value
+}
+
+const empty = ".google.protobuf.Empty"
+
+var fixtures = []fixture{
+	{
+		name:   "activities",
+		config: testConfig(),
+		file: fixtureFile("activities", []*descriptorpb.ServiceDescriptorProto{
+			service("Activities", &temporalv1.ServiceOptions{TaskQueue: "activities-queue"},
+				rpc("Fetch", ".fixtures.v1.FetchRequest", ".fixtures.v1.FetchResponse", temporalv1.E_Activity, &temporalv1.ActivityOptions{
+					ScheduleToCloseTimeout: proto.Int32(120),
+					StartToCloseTimeout:    proto.Int32(60),
+					ScheduleToStartTimeout: proto.Int32(30),
+					HeartbeatTimeout:       proto.Int32(10),
+					RetryPolicy: &temporalv1.RetryPolicy{
+						InitialInterval:        proto.Int32(1),
+						BackoffCoefficient:     proto.Float32(1.5),
+						MaximumInterval:        proto.Int32(10),
+						MaximumAttempts:        proto.Int32(5),
+						NonRetryableErrorTypes: []string{"FATAL"},
+					},
+				}),
+				rpc("Ping", empty, empty, temporalv1.E_Activity, &temporalv1.ActivityOptions{
+					Name: "custom.Ping",
+				}),
+				rpc("NoOptions", empty, empty, temporalv1.E_Activity, &temporalv1.ActivityOptions{}),
+			),
+		},
+			message("FetchRequest", field("url", descriptorpb.FieldDescriptorProto_TYPE_STRING)),
+			message("FetchResponse", field("body", descriptorpb.FieldDescriptorProto_TYPE_BYTES)),
+		),
+	},
+	{
+		// the workflow defaults are merged on their own, without mixing in the activity ones
+		name:   "workflow_defaults",
+		config: testConfig(),
+		file: fixtureFile("workflow_defaults", []*descriptorpb.ServiceDescriptorProto{
+			service("Orders", &temporalv1.ServiceOptions{
+				DefaultWorkflowOptions: &temporalv1.WorkflowOptions{
+					WorkflowRunTimeout:  proto.Int32(600),
+					WorkflowTaskTimeout: proto.Int32(10),
+					RetryPolicy: &temporalv1.RetryPolicy{
+						MaximumAttempts:        proto.Int32(3),
+						NonRetryableErrorTypes: []string{"WORKFLOW_FATAL"},
+					},
+				},
+			},
+				rpc("Place", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{}),
+			),
+			service("Refunds", &temporalv1.ServiceOptions{
+				DefaultActivityOptions: &temporalv1.ActivityOptions{
+					StartToCloseTimeout: proto.Int32(60),
+					RetryPolicy: &temporalv1.RetryPolicy{
+						NonRetryableErrorTypes: []string{"ACTIVITY_FATAL"},
+					},
+				},
+				DefaultWorkflowOptions: &temporalv1.WorkflowOptions{
+					RetryPolicy: &temporalv1.RetryPolicy{
+						NonRetryableErrorTypes: []string{"WORKFLOW_FATAL"},
+					},
+				},
+			},
+				rpc("Refund", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{}),
+				rpc("Reverse", empty, empty, temporalv1.E_Activity, &temporalv1.ActivityOptions{}),
+			),
+		}),
+	},
+	{
+		name:   "workflows",
+		config: testConfig(),
+		file: fixtureFile("workflows", []*descriptorpb.ServiceDescriptorProto{
+			service("Orders", &temporalv1.ServiceOptions{},
+				rpc("Process", ".fixtures.v1.ProcessRequest", ".fixtures.v1.ProcessResponse", temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					WorkflowExecutionTimeout: proto.Int32(3600),
+					WorkflowRunTimeout:       proto.Int32(600),
+					WorkflowTaskTimeout:      proto.Int32(10),
+					RetryPolicy: &temporalv1.RetryPolicy{
+						MaximumAttempts: proto.Int32(3),
+					},
+					Signals: []string{"Cancel", "Approve"},
+					Queries: []string{"Status"},
+					Updates: []string{"SetPriority"},
+				}),
+				rpc("Archive", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Name: "custom.Archive",
+				}),
+				rpc("Cancel", empty, empty, temporalv1.E_Signal, &temporalv1.SignalOptions{}),
+				rpc("Approve", ".fixtures.v1.ApproveRequest", empty, temporalv1.E_Signal, &temporalv1.SignalOptions{
+					Name: "custom.Approve",
+				}),
+				rpc("Status", empty, ".fixtures.v1.StatusResponse", temporalv1.E_Query, &temporalv1.QueryOptions{}),
+				rpc("SetPriority", ".fixtures.v1.SetPriorityRequest", ".fixtures.v1.SetPriorityResponse", temporalv1.E_Update, &temporalv1.UpdateOptions{}),
+			),
+		},
+			message("ProcessRequest", field("order_id", descriptorpb.FieldDescriptorProto_TYPE_STRING)),
+			message("ProcessResponse", field("ok", descriptorpb.FieldDescriptorProto_TYPE_BOOL)),
+			message("ApproveRequest", field("approver", descriptorpb.FieldDescriptorProto_TYPE_STRING)),
+			message("StatusResponse", field("status", descriptorpb.FieldDescriptorProto_TYPE_STRING)),
+			message("SetPriorityRequest", field("priority", descriptorpb.FieldDescriptorProto_TYPE_INT32)),
+			message("SetPriorityResponse", field("previous", descriptorpb.FieldDescriptorProto_TYPE_INT32)),
+		),
+	},
+	{
+		name:   "defaults",
+		config: testConfig(),
+		file: fixtureFile("defaults", []*descriptorpb.ServiceDescriptorProto{
+			service("Defaults", &temporalv1.ServiceOptions{
+				TaskQueue: "defaults-queue",
+				DefaultWorkflowOptions: &temporalv1.WorkflowOptions{
+					WorkflowExecutionTimeout: proto.Int32(86400),
+					WorkflowRunTimeout:       proto.Int32(7200),
+					IdReusePolicy:            temporalv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_REJECT_DUPLICATE,
+					ErrorWhenAlreadyStarted:  proto.Bool(true),
+					ParentClosePolicy:        temporalv1.ParentClosePolicy_PARENT_CLOSE_POLICY_ABANDON,
+				},
+				DefaultActivityOptions: &temporalv1.ActivityOptions{
+					StartToCloseTimeout: proto.Int32(30),
+					RetryPolicy: &temporalv1.RetryPolicy{
+						MaximumAttempts: proto.Int32(2),
+					},
+				},
+			},
+				rpc("Run", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{}),
+				rpc("Unique", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					WorkflowRunTimeout:      proto.Int32(60),
+					IdConflictPolicy:        temporalv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING,
+					ErrorWhenAlreadyStarted: proto.Bool(false),
+					ParentClosePolicy:       temporalv1.ParentClosePolicy_PARENT_CLOSE_POLICY_REQUEST_CANCEL,
+					WaitForCancellation:     proto.Bool(true),
+				}),
+				rpc("Step", empty, empty, temporalv1.E_Activity, &temporalv1.ActivityOptions{}),
+				rpc("Override", empty, empty, temporalv1.E_Activity, &temporalv1.ActivityOptions{
+					StartToCloseTimeout: proto.Int32(5),
+				}),
+			),
+		}),
+	},
+	{
+		name:   "missing_options",
+		config: testConfig(),
+		file: fixtureFile("missing_options", []*descriptorpb.ServiceDescriptorProto{
+			service("NotTemporal", nil,
+				rpc("Ignored", empty, empty, nil, nil),
+			),
+			service("PartiallyTemporal", &temporalv1.ServiceOptions{},
+				rpc("Plain", empty, empty, nil, nil),
+				rpc("Work", empty, empty, temporalv1.E_Activity, &temporalv1.ActivityOptions{}),
+			),
+		}),
+	},
+	{
+		name:   "no_service",
+		config: testConfig(),
+		file: fixtureFile("no_service", []*descriptorpb.ServiceDescriptorProto{
+			service("NotTemporal", nil,
+				rpc("Ignored", empty, empty, nil, nil),
+			),
+		}),
+	},
+	{
+		name:   "schedules",
+		config: &Config{GenWorkflowPrefix: true},
+		file: fixtureFile("schedules", []*descriptorpb.ServiceDescriptorProto{
+			service("Reports", &temporalv1.ServiceOptions{
+				DefaultWorkflowOptions: &temporalv1.WorkflowOptions{
+					WorkflowRunTimeout: proto.Int32(600),
+					Schedule: &temporalv1.ScheduleOptions{
+						CronExpressions: []string{"ignored in the defaults"},
+					},
+				},
+			},
+				rpc("Daily", ".fixtures.v1.ReportRequest", empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					RetryPolicy: &temporalv1.RetryPolicy{
+						MaximumAttempts: proto.Int32(3),
+					},
+					Schedule: &temporalv1.ScheduleOptions{
+						CronExpressions: []string{"0 6 * * *", "0 18 * * *"},
+						Jitter:          proto.Int32(300),
+						OverlapPolicy:   temporalv1.ScheduleOverlapPolicy_SCHEDULE_OVERLAP_POLICY_BUFFER_ONE,
+						PauseOnFailure:  true,
+					},
+				}),
+				rpc("Hourly", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Schedule: &temporalv1.ScheduleOptions{
+						Intervals: []*temporalv1.ScheduleInterval{
+							{Every: 3600},
+							{Every: 86400, Offset: 1800},
+						},
+					},
+				}),
+				rpc("Unscheduled", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{}),
+			),
+		},
+			message("ReportRequest", field("name", descriptorpb.FieldDescriptorProto_TYPE_STRING)),
+		),
+	},
+	{
+		name:   "id_templates",
+		config: &Config{GenWorkflowPrefix: true},
+		file: fixtureFile("id_templates", []*descriptorpb.ServiceDescriptorProto{
+			service("Shop", &temporalv1.ServiceOptions{},
+				rpc("Order", ".fixtures.v1.OrderRequest", empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					IdTemplate: "order/{customer_id}/{order_id}-100%",
+				}),
+				rpc("Fixed", ".fixtures.v1.OrderRequest", empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					IdTemplate: "singleton",
+				}),
+				rpc("Cart", ".fixtures.v1.OrderRequest", empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					IdTemplate: "cart/{customer_id}",
+					Signals:    []string{"AddItem"},
+				}),
+				rpc("AddItem", ".fixtures.v1.OrderRequest", empty, temporalv1.E_Signal, &temporalv1.SignalOptions{}),
+			),
+		},
+			message("OrderRequest",
+				field("order_id", descriptorpb.FieldDescriptorProto_TYPE_STRING),
+				field("customer_id", descriptorpb.FieldDescriptorProto_TYPE_INT64),
+			),
+		),
+	},
+	{
+		name:   "search_attributes",
+		config: testConfig(),
+		file: func() *descriptorpb.FileDescriptorProto {
+			file := withTimestamp(fixtureFile("search_attributes", []*descriptorpb.ServiceDescriptorProto{
+				service("Tickets", &temporalv1.ServiceOptions{},
+					rpc("Open", ".fixtures.v1.TicketRequest", empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+						Schedule: &temporalv1.ScheduleOptions{CronExpressions: []string{"@daily"}},
+					}),
+					rpc("Escalate", ".fixtures.v1.EscalateRequest", empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{}),
+					rpc("Close", ".fixtures.v1.EscalateRequest", empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{}),
+				),
+			},
+				message("TicketRequest",
+					indexed(field("customer_id", descriptorpb.FieldDescriptorProto_TYPE_STRING), &temporalv1.SearchAttributeOptions{Name: "CustomerId"}),
+					indexed(field("title", descriptorpb.FieldDescriptorProto_TYPE_STRING), &temporalv1.SearchAttributeOptions{
+						Name: "TicketTitle",
+						Type: temporalv1.IndexedValueType_INDEXED_VALUE_TYPE_TEXT,
+					}),
+					indexed(field("priority", descriptorpb.FieldDescriptorProto_TYPE_UINT32), &temporalv1.SearchAttributeOptions{}),
+					indexed(field("score", descriptorpb.FieldDescriptorProto_TYPE_FLOAT), &temporalv1.SearchAttributeOptions{Name: "Score"}),
+					indexed(field("urgent", descriptorpb.FieldDescriptorProto_TYPE_BOOL), &temporalv1.SearchAttributeOptions{Name: "Urgent"}),
+					indexed(messageField("status", descriptorpb.FieldDescriptorProto_TYPE_ENUM, ".fixtures.v1.Status"), &temporalv1.SearchAttributeOptions{Name: "Status"}),
+					indexed(messageField("due_at", descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".google.protobuf.Timestamp"), &temporalv1.SearchAttributeOptions{Name: "DueAt"}),
+					indexed(repeated(field("tags", descriptorpb.FieldDescriptorProto_TYPE_STRING)), &temporalv1.SearchAttributeOptions{Name: "Tags"}),
+					field("body", descriptorpb.FieldDescriptorProto_TYPE_STRING),
+				),
+				message("EscalateRequest",
+					indexed(field("customer_id", descriptorpb.FieldDescriptorProto_TYPE_STRING), &temporalv1.SearchAttributeOptions{Name: "CustomerId"}),
+				),
+			))
+			file.EnumType = []*descriptorpb.EnumDescriptorProto{{
+				Name: proto.String("Status"),
+				Value: []*descriptorpb.EnumValueDescriptorProto{
+					{Name: proto.String("STATUS_UNSPECIFIED"), Number: proto.Int32(0)},
+					{Name: proto.String("STATUS_OPEN"), Number: proto.Int32(1)},
+				},
+			}}
+			return file
+		}(),
+	},
+	{
+		name:   "memos",
+		config: testConfig(),
+		file: withTimestamp(fixtureFile("memos", []*descriptorpb.ServiceDescriptorProto{
+			service("Support", &temporalv1.ServiceOptions{},
+				rpc("Handle", ".fixtures.v1.HandleRequest", empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Signals: []string{"Reply"},
+				}),
+				rpc("Reply", empty, empty, temporalv1.E_Signal, &temporalv1.SignalOptions{}),
+			),
+		},
+			message("HandleRequest",
+				memo(field("customer_name", descriptorpb.FieldDescriptorProto_TYPE_STRING), &temporalv1.MemoOptions{}),
+				memo(field("source_system", descriptorpb.FieldDescriptorProto_TYPE_STRING), &temporalv1.MemoOptions{Key: "source"}),
+				memo(field("attempt", descriptorpb.FieldDescriptorProto_TYPE_INT32), &temporalv1.MemoOptions{}),
+				memo(repeated(field("labels", descriptorpb.FieldDescriptorProto_TYPE_STRING)), &temporalv1.MemoOptions{}),
+				memo(messageField("received_at", descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".google.protobuf.Timestamp"), &temporalv1.MemoOptions{}),
+				field("body", descriptorpb.FieldDescriptorProto_TYPE_STRING),
+			),
+		)),
+	},
+	{
+		name:   "local_activities",
+		config: &Config{GenDocs: true, DefaultActivityScheduleToClose: 60},
+		file: fixtureFile("local_activities", []*descriptorpb.ServiceDescriptorProto{
+			service("Lookups", &temporalv1.ServiceOptions{
+				DefaultActivityOptions: &temporalv1.ActivityOptions{
+					StartToCloseTimeout:      proto.Int32(300),
+					LocalStartToCloseTimeout: proto.Int32(5),
+					RetryPolicy: &temporalv1.RetryPolicy{
+						MaximumAttempts: proto.Int32(10),
+					},
+				},
+			},
+				rpc("Resolve", ".fixtures.v1.ResolveRequest", ".fixtures.v1.ResolveResponse", temporalv1.E_Activity, &temporalv1.ActivityOptions{
+					Local:                       true,
+					LocalScheduleToCloseTimeout: proto.Int32(10),
+					LocalRetryPolicy: &temporalv1.RetryPolicy{
+						InitialInterval: proto.Int32(1),
+						MaximumAttempts: proto.Int32(3),
+					},
+				}),
+				rpc("NewID", empty, ".fixtures.v1.ResolveResponse", temporalv1.E_Activity, &temporalv1.ActivityOptions{
+					Local: true,
+				}),
+				rpc("Remote", ".fixtures.v1.ResolveRequest", ".fixtures.v1.ResolveResponse", temporalv1.E_Activity, &temporalv1.ActivityOptions{
+					StartToCloseTimeout: proto.Int32(30),
+				}),
+			),
+			service("Unbounded", &temporalv1.ServiceOptions{},
+				rpc("Generate", empty, ".fixtures.v1.ResolveResponse", temporalv1.E_Activity, &temporalv1.ActivityOptions{
+					Local: true,
+				}),
+			),
+		},
+			message("ResolveRequest", field("name", descriptorpb.FieldDescriptorProto_TYPE_STRING)),
+			message("ResolveResponse", field("id", descriptorpb.FieldDescriptorProto_TYPE_STRING)),
+		),
+	},
+	{
+		name:   "heartbeats",
+		config: testConfig(),
+		file: withTimestamp(fixtureFile("heartbeats", []*descriptorpb.ServiceDescriptorProto{
+			service("Imports", &temporalv1.ServiceOptions{},
+				rpc("Import", ".fixtures.v1.ImportRequest", empty, temporalv1.E_Activity, &temporalv1.ActivityOptions{
+					HeartbeatTimeout: proto.Int32(30),
+					HeartbeatDetails: "ImportProgress",
+				}),
+				rpc("Export", ".fixtures.v1.ImportRequest", empty, temporalv1.E_Activity, &temporalv1.ActivityOptions{
+					HeartbeatDetails: ".fixtures.v1.ImportProgress",
+				}),
+				rpc("Sync", ".fixtures.v1.ImportRequest", empty, temporalv1.E_Activity, &temporalv1.ActivityOptions{
+					HeartbeatDetails: "google.protobuf.Timestamp",
+				}),
+			),
+		},
+			message("ImportRequest", field("url", descriptorpb.FieldDescriptorProto_TYPE_STRING)),
+			message("ImportProgress", field("offset", descriptorpb.FieldDescriptorProto_TYPE_INT64)),
+		)),
+	},
+	{
+		name:   "shared_signals",
+		config: &Config{DefaultActivityScheduleToClose: 3600 * 24, GenTestEnv: true},
+		deps:   []*descriptorpb.FileDescriptorProto{controlFile()},
+		file: withControl(fixtureFile("shared_signals", []*descriptorpb.ServiceDescriptorProto{
+			service("Jobs", &temporalv1.ServiceOptions{
+				DefaultWorkflowOptions: &temporalv1.WorkflowOptions{
+					Signals: []string{"common.v1.Control.Pause", "common.v1.Control.Resume"},
+				},
+			},
+				rpc("Build", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Signals: []string{"common.v1.Control.Pause", ".common.v1.Control.Resume", "Retry"},
+					Queries: []string{"common.v1.Control.State", "Progress"},
+				}),
+				rpc("Deploy", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{}),
+				rpc("Retry", empty, empty, temporalv1.E_Signal, &temporalv1.SignalOptions{}),
+				rpc("Progress", empty, empty, temporalv1.E_Query, &temporalv1.QueryOptions{}),
+			),
+		})),
+	},
+	{
+		name:   "application_errors",
+		config: testConfig(),
+		deps:   []*descriptorpb.FileDescriptorProto{controlFile()},
+		file: withControl(fixtureFile("application_errors", []*descriptorpb.ServiceDescriptorProto{
+			service("Payments", &temporalv1.ServiceOptions{
+				DefaultActivityOptions: &temporalv1.ActivityOptions{
+					Errors: []string{"Unavailable"},
+				},
+			},
+				rpc("Charge", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Errors: []string{"InsufficientFunds", ".common.v1.StateResponse"},
+				}),
+				rpc("Debit", empty, empty, temporalv1.E_Activity, &temporalv1.ActivityOptions{
+					Errors: []string{"fixtures.v1.InsufficientFunds"},
+				}),
+			),
+			service("Refunds", &temporalv1.ServiceOptions{},
+				rpc("Refund", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Errors: []string{"InsufficientFunds"},
+				}),
+			),
+		},
+			message("InsufficientFunds",
+				field("balance", descriptorpb.FieldDescriptorProto_TYPE_INT64),
+				field("required", descriptorpb.FieldDescriptorProto_TYPE_INT64),
+			),
+			message("Unavailable", field("provider", descriptorpb.FieldDescriptorProto_TYPE_STRING)),
+		)),
+	},
+	{
+		name:   "continue_as_new",
+		config: &Config{GenDocs: true, DefaultActivityScheduleToClose: 60},
+		file: fixtureFile("continue_as_new", []*descriptorpb.ServiceDescriptorProto{
+			service("Crawler", &temporalv1.ServiceOptions{
+				DefaultWorkflowOptions: &temporalv1.WorkflowOptions{
+					ContinueAsNewHistoryLength: proto.Int64(10000),
+				},
+			},
+				rpc("Crawl", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					ContinueAsNewHistorySize: proto.Int64(10 * 1024 * 1024),
+				}),
+				rpc("Index", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Name:                       "index",
+					ContinueAsNewHistoryLength: proto.Int64(500),
+				}),
+			),
+		}),
+	},
+	{
+		name: "all_options",
+		config: &Config{
+			GenWorkflowPrefix:              true,
+			GenDocs:                        true,
+			GenTestEnv:                     true,
+			DefaultActivityScheduleToClose: 3600,
+		},
+		file: fixtureFile("all_options", []*descriptorpb.ServiceDescriptorProto{
+			service("Everything", &temporalv1.ServiceOptions{TaskQueue: "everything"},
+				rpc("Run", ".fixtures.v1.RunRequest", ".fixtures.v1.RunResponse", temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Signals:             []string{"Poke"},
+					Queries:             []string{"Peek"},
+					Updates:             []string{"Tweak"},
+					ParentClosePolicy:   temporalv1.ParentClosePolicy_PARENT_CLOSE_POLICY_TERMINATE,
+					WaitForCancellation: proto.Bool(true),
+				}),
+				rpc("Act", ".fixtures.v1.RunRequest", ".fixtures.v1.RunResponse", temporalv1.E_Activity, &temporalv1.ActivityOptions{}),
+				rpc("Poke", empty, empty, temporalv1.E_Signal, &temporalv1.SignalOptions{}),
+				rpc("Peek", empty, ".fixtures.v1.RunResponse", temporalv1.E_Query, &temporalv1.QueryOptions{Name: "custom.Peek"}),
+				rpc("Tweak", ".fixtures.v1.RunRequest", ".fixtures.v1.RunResponse", temporalv1.E_Update, &temporalv1.UpdateOptions{Name: "custom.Tweak"}),
+			),
+		},
+			message("RunRequest", field("value", descriptorpb.FieldDescriptorProto_TYPE_INT64)),
+			message("RunResponse", field("value", descriptorpb.FieldDescriptorProto_TYPE_INT64)),
+		),
+	},
+	{
+		name:   "error_enums",
+		config: &Config{GenDocs: true, DefaultActivityScheduleToClose: 60},
+		file: withEnums(fixtureFile("error_enums", []*descriptorpb.ServiceDescriptorProto{
+			service("Orders", &temporalv1.ServiceOptions{
+				DefaultActivityOptions: &temporalv1.ActivityOptions{
+					RetryPolicy: &temporalv1.RetryPolicy{
+						MaximumAttempts:        proto.Int32(5),
+						NonRetryableErrorEnum:  "ErrorCode",
+						NonRetryableErrorTypes: []string{"fixtures.v1.OutOfStock"},
+					},
+				},
+			},
+				rpc("Place", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					RetryPolicy: &temporalv1.RetryPolicy{
+						MaximumAttempts:       proto.Int32(2),
+						NonRetryableErrorEnum: "fixtures.v1.ErrorCode",
+					},
+				}),
+				rpc("Reserve", empty, empty, temporalv1.E_Activity, &temporalv1.ActivityOptions{
+					Errors: []string{"OutOfStock"},
+				}),
+				rpc("Notify", empty, empty, temporalv1.E_Activity, &temporalv1.ActivityOptions{
+					RetryPolicy: &temporalv1.RetryPolicy{
+						NonRetryableErrorTypes: []string{"FATAL"},
+					},
+				}),
+			),
+		},
+			message("OutOfStock", field("sku", descriptorpb.FieldDescriptorProto_TYPE_STRING)),
+		),
+			enum("ErrorCode",
+				enumValue("ERROR_CODE_UNSPECIFIED", nil),
+				enumValue("ERROR_CODE_NOT_FOUND", nil),
+				enumValue("ERROR_CODE_INVALID_ARGUMENT", &temporalv1.ErrorOptions{}),
+				enumValue("ERROR_CODE_UNAVAILABLE", &temporalv1.ErrorOptions{Retryable: true}),
+			),
+		),
+	},
+	{
+		name:     "shared_package_errors",
+		config:   testConfig(),
+		siblings: sharedErrorsFiles(),
+		file: fixtureFile("shared_package_errors", []*descriptorpb.ServiceDescriptorProto{
+			service("Refunds", &temporalv1.ServiceOptions{},
+				rpc("Refund", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Errors: []string{"Shared", "Local"},
+					RetryPolicy: &temporalv1.RetryPolicy{
+						NonRetryableErrorEnum: "Code",
+					},
+				}),
+			),
+		}),
+	},
+	{
+		name:   "proto_converter",
+		config: &Config{DefaultActivityScheduleToClose: 60, ProtoConverter: ProtoConverterBinary},
+		file: withEnums(withTimestamp(fixtureFile("proto_converter", []*descriptorpb.ServiceDescriptorProto{
+			service("Ledger", &temporalv1.ServiceOptions{},
+				rpc("Record", ".fixtures.v1.Entry", ".fixtures.v1.Receipt", temporalv1.E_Workflow, &temporalv1.WorkflowOptions{}),
+				rpc("Store", ".fixtures.v1.Entry", empty, temporalv1.E_Activity, &temporalv1.ActivityOptions{}),
+			),
+		},
+			message("Entry",
+				memo(field("id", descriptorpb.FieldDescriptorProto_TYPE_STRING), &temporalv1.MemoOptions{}),
+				field("amount", descriptorpb.FieldDescriptorProto_TYPE_INT64),
+				messageField("kind", descriptorpb.FieldDescriptorProto_TYPE_ENUM, ".fixtures.v1.Kind"),
+				repeated(field("tags", descriptorpb.FieldDescriptorProto_TYPE_STRING)),
+				messageField("at", descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".google.protobuf.Timestamp"),
+			),
+			message("Receipt",
+				repeated(messageField("entries", descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".fixtures.v1.Entry")),
+				field("signature", descriptorpb.FieldDescriptorProto_TYPE_BYTES),
+			),
+		)),
+			enum("Kind",
+				enumValue("KIND_UNSPECIFIED", nil),
+				enumValue("KIND_CREDIT", nil),
+			),
+		),
+	},
+	{
+		name:   "proto_json_converter",
+		config: &Config{DefaultActivityScheduleToClose: 60, ProtoConverter: ProtoConverterJSON},
+		file: fixtureFile("proto_json_converter", []*descriptorpb.ServiceDescriptorProto{
+			service("Echo", &temporalv1.ServiceOptions{},
+				rpc("Say", ".fixtures.v1.Message", ".fixtures.v1.Message", temporalv1.E_Workflow, &temporalv1.WorkflowOptions{}),
+			),
+		},
+			message("Message", field("text", descriptorpb.FieldDescriptorProto_TYPE_STRING)),
+		),
+	},
+	{
+		name:   "durations",
+		config: &Config{GenDocs: true, DefaultActivityScheduleToClose: 60},
+		file: fixtureFile("durations", []*descriptorpb.ServiceDescriptorProto{
+			service("Timer", &temporalv1.ServiceOptions{
+				DefaultWorkflowOptions: &temporalv1.WorkflowOptions{
+					WorkflowExecutionTimeoutDuration: durationpb.New(36 * time.Hour),
+					WorkflowTaskTimeout:              proto.Int32(10),
+				},
+			},
+				rpc("Tick", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					WorkflowRunTimeoutDuration: durationpb.New(90 * time.Minute),
+					RetryPolicy: &temporalv1.RetryPolicy{
+						InitialIntervalDuration: durationpb.New(250 * time.Millisecond),
+						MaximumInterval:         proto.Int32(5),
+					},
+				}),
+				rpc("Lookup", empty, empty, temporalv1.E_Activity, &temporalv1.ActivityOptions{
+					StartToCloseTimeoutDuration:      durationpb.New(1500 * time.Millisecond),
+					ScheduleToCloseTimeout:           proto.Int32(10),
+					HeartbeatTimeoutDuration:         durationpb.New(time.Second),
+					Local:                            true,
+					LocalStartToCloseTimeoutDuration: durationpb.New(200 * time.Microsecond),
+					LocalRetryPolicy: &temporalv1.RetryPolicy{
+						MaximumIntervalDuration: durationpb.New(100 * time.Millisecond),
+					},
+				}),
+			),
+		}),
+	},
+	{
+		name:   "sensitive_fields",
+		config: &Config{GenDocs: true, DefaultActivityScheduleToClose: 60},
+		file: fixtureFile("sensitive_fields", []*descriptorpb.ServiceDescriptorProto{
+			service("Onboarding", &temporalv1.ServiceOptions{},
+				rpc("Register", ".fixtures.v1.RegisterRequest", ".fixtures.v1.Account", temporalv1.E_Workflow, &temporalv1.WorkflowOptions{}),
+				rpc("Notify", ".fixtures.v1.Contact", empty, temporalv1.E_Activity, &temporalv1.ActivityOptions{}),
+			),
+		},
+			message("Contact",
+				sensitive(field("email", descriptorpb.FieldDescriptorProto_TYPE_STRING)),
+				sensitive(field("phone", descriptorpb.FieldDescriptorProto_TYPE_STRING)),
+				field("locale", descriptorpb.FieldDescriptorProto_TYPE_STRING),
+			),
+			message("RegisterRequest",
+				field("username", descriptorpb.FieldDescriptorProto_TYPE_STRING),
+				messageField("contact", descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".fixtures.v1.Contact"),
+			),
+			message("Account",
+				field("id", descriptorpb.FieldDescriptorProto_TYPE_STRING),
+				repeated(messageField("referrals", descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, ".fixtures.v1.Account")),
+			),
+		),
+	},
+	{
+		name:   "validation",
+		config: &Config{DefaultActivityScheduleToClose: 60, Validation: true},
+		file: fixtureFile("validation", []*descriptorpb.ServiceDescriptorProto{
+			service("Orders", &temporalv1.ServiceOptions{},
+				rpc("Place", ".fixtures.v1.Order", empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Signals:           []string{"Amend"},
+					Updates:           []string{"Reprice"},
+					ValidateOnReceipt: proto.Bool(true),
+					Schedule: &temporalv1.ScheduleOptions{
+						CronExpressions: []string{"0 * * * *"},
+					},
+				}),
+				rpc("Amend", ".fixtures.v1.Order", empty, temporalv1.E_Signal, &temporalv1.SignalOptions{}),
+				rpc("Reprice", ".fixtures.v1.Order", empty, temporalv1.E_Update, &temporalv1.UpdateOptions{}),
+				rpc("Charge", ".fixtures.v1.Order", empty, temporalv1.E_Activity, &temporalv1.ActivityOptions{
+					Local: true,
+				}),
+				rpc("Refund", ".fixtures.v1.Order", empty, temporalv1.E_Activity, &temporalv1.ActivityOptions{
+					Validate: proto.Bool(false),
+				}),
+			),
+		},
+			message("Order", field("id", descriptorpb.FieldDescriptorProto_TYPE_STRING)),
+		),
+	},
+	{
+		name:   "validation_options",
+		config: &Config{DefaultActivityScheduleToClose: 60},
+		file: fixtureFile("validation_options", []*descriptorpb.ServiceDescriptorProto{
+			service("Orders", &temporalv1.ServiceOptions{
+				DefaultActivityOptions: &temporalv1.ActivityOptions{
+					Validate: proto.Bool(true),
+				},
+			},
+				rpc("Place", ".fixtures.v1.Order", empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Signals: []string{"Amend", "Cancel"},
+				}),
+				rpc("Amend", ".fixtures.v1.Order", empty, temporalv1.E_Signal, &temporalv1.SignalOptions{
+					Validate: proto.Bool(true),
+				}),
+				rpc("Cancel", ".fixtures.v1.Order", empty, temporalv1.E_Signal, &temporalv1.SignalOptions{}),
+				rpc("Charge", ".fixtures.v1.Order", empty, temporalv1.E_Activity, &temporalv1.ActivityOptions{}),
+			),
+		},
+			message("Order", field("id", descriptorpb.FieldDescriptorProto_TYPE_STRING)),
+		),
+	},
+	{
+		name:   "validation_on_receipt",
+		config: &Config{DefaultActivityScheduleToClose: 60, ValidationOnReceipt: true},
+		file: fixtureFile("validation_on_receipt", []*descriptorpb.ServiceDescriptorProto{
+			service("Orders", &temporalv1.ServiceOptions{
+				DefaultActivityOptions: &temporalv1.ActivityOptions{
+					ValidateOnReceipt: proto.Bool(false),
+				},
+			},
+				rpc("Place", ".fixtures.v1.Order", empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{}),
+				rpc("Charge", ".fixtures.v1.Order", empty, temporalv1.E_Activity, &temporalv1.ActivityOptions{}),
+				rpc("Ship", ".fixtures.v1.Order", empty, temporalv1.E_Activity, &temporalv1.ActivityOptions{
+					ValidateOnReceipt: proto.Bool(true),
+				}),
+			),
+		},
+			message("Order", field("id", descriptorpb.FieldDescriptorProto_TYPE_STRING)),
+		),
+	},
+	{
+		name:   "client_interceptors",
+		config: testConfig(),
+		file: fixtureFile("client_interceptors", []*descriptorpb.ServiceDescriptorProto{
+			service("Tickets", &temporalv1.ServiceOptions{},
+				rpc("Open", ".fixtures.v1.Ticket", empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Signals: []string{"Comment"},
+					Queries: []string{"Status"},
+					Updates: []string{"Assign"},
+				}),
+				rpc("Comment", ".fixtures.v1.Ticket", empty, temporalv1.E_Signal, &temporalv1.SignalOptions{}),
+				rpc("Status", empty, ".fixtures.v1.Ticket", temporalv1.E_Query, &temporalv1.QueryOptions{}),
+				rpc("Assign", ".fixtures.v1.Ticket", ".fixtures.v1.Ticket", temporalv1.E_Update, &temporalv1.UpdateOptions{}),
+			),
+		},
+			message("Ticket", field("id", descriptorpb.FieldDescriptorProto_TYPE_STRING)),
+		),
+	},
+	{
+		name:   "service_middleware",
+		config: testConfig(),
+		file: fixtureFile("service_middleware", []*descriptorpb.ServiceDescriptorProto{
+			service("Billing", &temporalv1.ServiceOptions{},
+				rpc("Invoice", ".fixtures.v1.Invoice", ".fixtures.v1.Invoice", temporalv1.E_Workflow, &temporalv1.WorkflowOptions{}),
+				rpc("Charge", ".fixtures.v1.Invoice", empty, temporalv1.E_Activity, &temporalv1.ActivityOptions{
+					Name: "billing.charge",
+				}),
+			),
+		},
+			message("Invoice", field("id", descriptorpb.FieldDescriptorProto_TYPE_STRING)),
+		),
+	},
+}
+
+// invalidFixtures are fixtures the generator must refuse
+var invalidFixtures = []fixture{
+	{
+		name:   "unknown_signal",
+		err:    "no signal DoesNotExist defined for service Broken",
+		config: testConfig(),
+		file: fixtureFile("unknown_signal", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{},
+				rpc("Run", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Signals: []string{"DoesNotExist"},
+				}),
+			),
+		}),
+	},
+	{
+		name:   "unknown_query",
+		err:    "no query DoesNotExist defined for service Broken",
+		config: testConfig(),
+		file: fixtureFile("unknown_query", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{},
+				rpc("Run", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Queries: []string{"DoesNotExist"},
+				}),
+			),
+		}),
+	},
+	{
+		name:   "terminate_if_running",
+		err:    "the TERMINATE_IF_RUNNING ID reuse policy of workflow fixtures.v1.Broken.Run cannot be used",
+		config: testConfig(),
+		file: fixtureFile("terminate_if_running", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{
+				DefaultWorkflowOptions: &temporalv1.WorkflowOptions{
+					IdReusePolicy: temporalv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_TERMINATE_IF_RUNNING,
+				},
+			},
+				rpc("Run", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{}),
+			),
+		}),
+	},
+	{
+		name:   "empty_schedule",
+		err:    "needs at least a cron expression or an interval",
+		config: testConfig(),
+		file: fixtureFile("empty_schedule", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{},
+				rpc("Run", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Schedule: &temporalv1.ScheduleOptions{
+						Jitter: proto.Int32(10),
+					},
+				}),
+			),
+		}),
+	},
+	{
+		name:   "schedule_interval_not_positive",
+		err:    "has an interval with a period that is not positive",
+		config: testConfig(),
+		file: fixtureFile("schedule_interval_not_positive", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{},
+				rpc("Run", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Schedule: &temporalv1.ScheduleOptions{
+						Intervals: []*temporalv1.ScheduleInterval{{Every: 0}},
+					},
+				}),
+			),
+		}),
+	},
+	{
+		name:   "schedule_negative_offset",
+		err:    "has an interval with a negative offset",
+		config: testConfig(),
+		file: fixtureFile("schedule_negative_offset", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{},
+				rpc("Run", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Schedule: &temporalv1.ScheduleOptions{
+						Intervals: []*temporalv1.ScheduleInterval{{Every: 3600, Offset: -60}},
+					},
+				}),
+			),
+		}),
+	},
+	{
+		name:   "schedule_offset_too_long",
+		err:    "has an interval with an offset of 3600s, it must be shorter than its 3600s period",
+		config: testConfig(),
+		file: fixtureFile("schedule_offset_too_long", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{},
+				rpc("Run", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Schedule: &temporalv1.ScheduleOptions{
+						Intervals: []*temporalv1.ScheduleInterval{{Every: 3600, Offset: 3600}},
+					},
+				}),
+			),
+		}),
+	},
+	{
+		name:   "schedule_negative_jitter",
+		err:    "has a negative jitter",
+		config: testConfig(),
+		file: fixtureFile("schedule_negative_jitter", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{},
+				rpc("Run", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Schedule: &temporalv1.ScheduleOptions{
+						Intervals: []*temporalv1.ScheduleInterval{{Every: 3600}},
+						Jitter:    proto.Int32(-10),
+					},
+				}),
+			),
+		}),
+	},
+	{
+		name:   "id_template_unknown_field",
+		err:    "fixtures.v1.RunRequest has no field \"unknown\"",
+		config: testConfig(),
+		file: fixtureFile("id_template_unknown_field", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{},
+				rpc("Run", ".fixtures.v1.RunRequest", empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					IdTemplate: "run/{unknown}",
+				}),
+			),
+		},
+			message("RunRequest", field("id", descriptorpb.FieldDescriptorProto_TYPE_STRING)),
+		),
+	},
+	{
+		name:   "id_template_unclosed",
+		err:    "unclosed '{'",
+		config: testConfig(),
+		file: fixtureFile("id_template_unclosed", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{},
+				rpc("Run", ".fixtures.v1.RunRequest", empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					IdTemplate: "run/{id",
+				}),
+			),
+		},
+			message("RunRequest", field("id", descriptorpb.FieldDescriptorProto_TYPE_STRING)),
+		),
+	},
+	{
+		name:   "id_template_not_scalar",
+		err:    "field \"payload\" is not a scalar",
+		config: testConfig(),
+		file: fixtureFile("id_template_not_scalar", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{},
+				rpc("Run", ".fixtures.v1.RunRequest", empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					IdTemplate: "run/{payload}",
+				}),
+			),
+		},
+			message("RunRequest", field("payload", descriptorpb.FieldDescriptorProto_TYPE_BYTES)),
+		),
+	},
+	{
+		name:   "activity_and_workflow",
+		err:    "cannot be both an activity and a workflow",
+		config: testConfig(),
+		file: func() *descriptorpb.FileDescriptorProto {
+			meth := rpc("Both", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{})
+			proto.SetExtension(meth.Options, temporalv1.E_Activity, &temporalv1.ActivityOptions{})
+			return fixtureFile("activity_and_workflow", []*descriptorpb.ServiceDescriptorProto{
+				service("Broken", &temporalv1.ServiceOptions{}, meth),
+			})
+		}(),
+	},
+	{
+		name:   "search_attribute_not_indexable",
+		err:    "fixtures.v1.RunRequest.payload cannot be used as a search attribute",
+		config: testConfig(),
+		file: fixtureFile("search_attribute_not_indexable", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{},
+				rpc("Run", ".fixtures.v1.RunRequest", empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{}),
+			),
+		},
+			message("RunRequest", indexed(field("payload", descriptorpb.FieldDescriptorProto_TYPE_BYTES), &temporalv1.SearchAttributeOptions{Name: "Payload"})),
+		),
+	},
+	{
+		name:   "search_attribute_wrong_type",
+		err:    "cannot be used as a search attribute of type INDEXED_VALUE_TYPE_KEYWORD",
+		config: testConfig(),
+		file: fixtureFile("search_attribute_wrong_type", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{},
+				rpc("Run", ".fixtures.v1.RunRequest", empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{}),
+			),
+		},
+			message("RunRequest", indexed(field("count", descriptorpb.FieldDescriptorProto_TYPE_INT64), &temporalv1.SearchAttributeOptions{
+				Name: "Count",
+				Type: temporalv1.IndexedValueType_INDEXED_VALUE_TYPE_KEYWORD,
+			})),
+		),
+	},
+	{
+		name:   "search_attribute_conflicting_types",
+		err:    "is declared with both the INDEXED_VALUE_TYPE_INT and INDEXED_VALUE_TYPE_KEYWORD types",
+		config: testConfig(),
+		file: fixtureFile("search_attribute_conflicting_types", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{},
+				rpc("Run", ".fixtures.v1.RunRequest", empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{}),
+				rpc("Walk", ".fixtures.v1.WalkRequest", empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{}),
+			),
+		},
+			message("RunRequest", indexed(field("count", descriptorpb.FieldDescriptorProto_TYPE_INT64), &temporalv1.SearchAttributeOptions{Name: "Count"})),
+			message("WalkRequest", indexed(field("count", descriptorpb.FieldDescriptorProto_TYPE_STRING), &temporalv1.SearchAttributeOptions{Name: "Count"})),
+		),
+	},
+	{
+		name:   "memo_duplicate_key",
+		err:    "memo key \"name\" is used by both",
+		config: testConfig(),
+		file: fixtureFile("memo_duplicate_key", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{},
+				rpc("Run", ".fixtures.v1.RunRequest", empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{}),
+			),
+		},
+			message("RunRequest",
+				memo(field("name", descriptorpb.FieldDescriptorProto_TYPE_STRING), &temporalv1.MemoOptions{Key: "name"}),
+				memo(field("other_name", descriptorpb.FieldDescriptorProto_TYPE_STRING), &temporalv1.MemoOptions{Key: "name"}),
+			),
+		),
+	},
+	{
+		name:   "sensitive_memo",
+		err:    "sensitive field fixtures.v1.RunRequest.email cannot be used in a memo",
+		config: testConfig(),
+		file: fixtureFile("sensitive_memo", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{},
+				rpc("Run", ".fixtures.v1.RunRequest", empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{}),
+			),
+		},
+			message("RunRequest", sensitive(memo(field("email", descriptorpb.FieldDescriptorProto_TYPE_STRING), &temporalv1.MemoOptions{}))),
+		),
+	},
+	{
+		name:   "unknown_heartbeat_details",
+		err:    "invalid heartbeat details for activity fixtures.v1.Broken.Run",
+		config: testConfig(),
+		file: fixtureFile("unknown_heartbeat_details", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{},
+				rpc("Run", empty, empty, temporalv1.E_Activity, &temporalv1.ActivityOptions{
+					HeartbeatDetails: "DoesNotExist",
+				}),
+			),
+		}),
+	},
+	{
+		name:   "unknown_shared_signal",
+		err:    "no signal common.v1.Control.Stop defined for service Broken",
+		config: testConfig(),
+		deps:   []*descriptorpb.FileDescriptorProto{controlFile()},
+		file: withControl(fixtureFile("unknown_shared_signal", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{},
+				rpc("Run", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Signals: []string{"common.v1.Control.Stop"},
+				}),
+			),
+		})),
+	},
+	{
+		name:   "shared_query_as_signal",
+		err:    "no signal common.v1.Control.State defined for service Broken",
+		config: testConfig(),
+		deps:   []*descriptorpb.FileDescriptorProto{controlFile()},
+		file: withControl(fixtureFile("shared_query_as_signal", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{},
+				rpc("Run", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Signals: []string{"common.v1.Control.State"},
+				}),
+			),
+		})),
+	},
+	{
+		name:   "shared_signal_name_clash",
+		err:    "invalid workflow Run: common.v1.Control.Pause and fixtures.v1.Broken.Pause would generate the same methods",
+		config: testConfig(),
+		deps:   []*descriptorpb.FileDescriptorProto{controlFile()},
+		file: withControl(fixtureFile("shared_signal_name_clash", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{},
+				rpc("Run", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Signals: []string{"common.v1.Control.Pause", "Pause"},
+				}),
+				rpc("Pause", empty, empty, temporalv1.E_Signal, &temporalv1.SignalOptions{}),
+			),
+		})),
+	},
+	{
+		name:   "client_hook_name_clash",
+		err:    "fixtures.v1.Broken.Pause and common.v1.Control.Pause would generate the same client interceptor hooks",
+		config: testConfig(),
+		deps:   []*descriptorpb.FileDescriptorProto{controlFile()},
+		file: withControl(fixtureFile("client_hook_name_clash", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{},
+				rpc("Run", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Signals: []string{"Pause"},
+				}),
+				rpc("Walk", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Signals: []string{"common.v1.Control.Pause"},
+				}),
+				rpc("Pause", empty, empty, temporalv1.E_Signal, &temporalv1.SignalOptions{}),
+			),
+		})),
+	},
+	{
+		name:   "unknown_error",
+		err:    "invalid errors for fixtures.v1.Broken.Run: no message DoesNotExist found",
+		config: testConfig(),
+		file: fixtureFile("unknown_error", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{},
+				rpc("Run", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Errors: []string{"DoesNotExist"},
+				}),
+			),
+		}),
+	},
+	{
+		name:   "error_name_clash",
+		err:    "error messages fixtures.v1.PauseRequest and common.v1.PauseRequest would generate the same functions",
+		config: testConfig(),
+		deps:   []*descriptorpb.FileDescriptorProto{controlFile()},
+		file: withControl(fixtureFile("error_name_clash", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{},
+				rpc("Run", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					Errors: []string{"PauseRequest", "common.v1.PauseRequest"},
+				}),
+			),
+		},
+			message("PauseRequest"),
+		)),
+	},
+	{
+		name:   "unknown_error_enum",
+		err:    "invalid non retryable error enum: no enum DoesNotExist found",
+		config: testConfig(),
+		file: fixtureFile("unknown_error_enum", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{},
+				rpc("Run", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					RetryPolicy: &temporalv1.RetryPolicy{
+						NonRetryableErrorEnum: "DoesNotExist",
+					},
+				}),
+			),
+		}),
+	},
+	{
+		name:   "unknown_non_retryable_error_type",
+		err:    "unknown non retryable error type \"fixtures.v1.ERROR_CODE_NOT_FOUDN\"",
+		config: testConfig(),
+		file: withEnums(fixtureFile("unknown_non_retryable_error_type", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{},
+				rpc("Run", empty, empty, temporalv1.E_Activity, &temporalv1.ActivityOptions{
+					RetryPolicy: &temporalv1.RetryPolicy{
+						NonRetryableErrorEnum:  "ErrorCode",
+						NonRetryableErrorTypes: []string{"fixtures.v1.ERROR_CODE_NOT_FOUDN"},
+					},
+				}),
+			),
+		}),
+			enum("ErrorCode",
+				enumValue("ERROR_CODE_UNSPECIFIED", nil),
+				enumValue("ERROR_CODE_NOT_FOUND", nil),
+			),
+		),
+	},
+	{
+		name:   "unknown_proto_converter",
+		err:    "unknown proto converter \"xml\"",
+		config: &Config{DefaultActivityScheduleToClose: 60, ProtoConverter: "xml"},
+		file: fixtureFile("unknown_proto_converter", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{},
+				rpc("Run", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{}),
+			),
+		}),
+	},
+	{
+		name:   "timeout_set_twice",
+		err:    "start_to_close_timeout is set both in seconds and as a duration",
+		config: testConfig(),
+		file: fixtureFile("timeout_set_twice", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{},
+				rpc("Run", empty, empty, temporalv1.E_Activity, &temporalv1.ActivityOptions{
+					StartToCloseTimeout:         proto.Int32(5),
+					StartToCloseTimeoutDuration: durationpb.New(5 * time.Second),
+				}),
+			),
+		}),
+	},
+	{
+		name:   "retry_interval_set_twice",
+		err:    "retry_policy.initial_interval is set both in seconds and as a duration",
+		config: testConfig(),
+		file: fixtureFile("retry_interval_set_twice", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{
+				DefaultWorkflowOptions: &temporalv1.WorkflowOptions{
+					RetryPolicy: &temporalv1.RetryPolicy{
+						InitialInterval:         proto.Int32(1),
+						InitialIntervalDuration: durationpb.New(time.Second),
+					},
+				},
+			},
+				rpc("Run", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{}),
+			),
+		}),
+	},
+	{
+		name:   "timeout_too_long",
+		err:    "invalid workflow_execution_timeout: longer than",
+		config: testConfig(),
+		file: fixtureFile("timeout_too_long", []*descriptorpb.ServiceDescriptorProto{
+			service("Broken", &temporalv1.ServiceOptions{},
+				rpc("Run", empty, empty, temporalv1.E_Workflow, &temporalv1.WorkflowOptions{
+					WorkflowExecutionTimeoutDuration: &durationpb.Duration{Seconds: 300 * 365 * 24 * 3600},
+				}),
+			),
+		}),
+	},
+}
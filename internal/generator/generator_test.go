@@ -0,0 +1,249 @@
+package generator
+
+import (
+	"flag"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	temporalv1 "github.com/thomas-maurice/protoc-gen-go-tmprl/gen/temporal/v1"
+	"google.golang.org/protobuf/cmd/protoc-gen-go/internal_gengo"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+// newPlugin builds a protogen.Plugin generating the fixture file
+func newPlugin(t *testing.T, fix fixture) *protogen.Plugin {
+	t.Helper()
+
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{fix.file.GetName()},
+		ProtoFile: []*descriptorpb.FileDescriptorProto{
+			protodesc.ToFileDescriptorProto(descriptorpb.File_google_protobuf_descriptor_proto),
+			protodesc.ToFileDescriptorProto(durationpb.File_google_protobuf_duration_proto),
+			protodesc.ToFileDescriptorProto(emptypb.File_google_protobuf_empty_proto),
+			protodesc.ToFileDescriptorProto(timestamppb.File_google_protobuf_timestamp_proto),
+			protodesc.ToFileDescriptorProto(temporalv1.File_temporal_v1_temporal_proto),
+		},
+		Parameter: proto.String("paths=source_relative"),
+	}
+	req.ProtoFile = append(req.ProtoFile, fix.deps...)
+	req.ProtoFile = append(req.ProtoFile, fix.file)
+	for _, sibling := range fix.siblings {
+		req.FileToGenerate = append(req.FileToGenerate, sibling.GetName())
+		req.ProtoFile = append(req.ProtoFile, sibling)
+	}
+
+	plugin, err := protogen.Options{}.New(req)
+	if err != nil {
+		t.Fatalf("could not create plugin: %s", err)
+	}
+
+	return plugin
+}
+
+// generate runs the generator the same way the protoc plugin does
+func generate(t *testing.T, fix fixture) *pluginpb.CodeGeneratorResponse {
+	t.Helper()
+
+	plugin := newPlugin(t, fix)
+	for _, f := range plugin.Files {
+		if !f.Generate {
+			continue
+		}
+		GenerateFile(plugin, f, fix.config)
+		if fix.config.GenDocs {
+			GenerateReadme(plugin, f, fix.config)
+		}
+		if fix.config.GenTestEnv {
+			GenerateTestEnv(plugin, f, fix.config)
+		}
+		if fix.config.ProtoConverter != "" {
+			GenerateConverterTest(plugin, f, fix.config)
+		}
+	}
+
+	return plugin.Response()
+}
+
+func TestGolden(t *testing.T) {
+	for _, fix := range fixtures {
+		t.Run(fix.name, func(t *testing.T) {
+			resp := generate(t, fix)
+			if resp.Error != nil {
+				t.Fatalf("generation failed: %s", resp.GetError())
+			}
+
+			dir := filepath.Join("testdata", fix.name)
+			if *update {
+				if err := os.RemoveAll(dir); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if *update && len(resp.File) > 0 {
+				if err := os.MkdirAll(dir, 0o755); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			generated := make(map[string]bool)
+			for _, f := range resp.File {
+				golden := filepath.Join(dir, filepath.Base(f.GetName())+".golden")
+				generated[golden] = true
+
+				if *update {
+					if err := os.WriteFile(golden, []byte(f.GetContent()), 0o644); err != nil {
+						t.Fatal(err)
+					}
+					continue
+				}
+
+				expected, err := os.ReadFile(golden)
+				if err != nil {
+					t.Fatalf("could not read golden file, run the tests with -update to create it: %s", err)
+				}
+
+				if string(expected) != f.GetContent() {
+					t.Errorf("%s does not match the golden file %s, run the tests with -update if this is expected", f.GetName(), golden)
+				}
+			}
+
+			// golden files that are not generated anymore
+			existing, _ := filepath.Glob(filepath.Join(dir, "*.golden"))
+			for _, golden := range existing {
+				if !generated[golden] {
+					t.Errorf("%s was not generated", golden)
+				}
+			}
+		})
+	}
+}
+
+func TestInvalid(t *testing.T) {
+	for _, fix := range invalidFixtures {
+		t.Run(fix.name, func(t *testing.T) {
+			resp := generate(t, fix)
+			if resp.Error == nil {
+				t.Fatal("expected the generation to fail")
+			}
+			if !strings.Contains(resp.GetError(), fix.err) {
+				t.Errorf("expected an error containing %q, got %q", fix.err, resp.GetError())
+			}
+		})
+	}
+}
+
+// TestTypeCheck type checks the generated code along with the
+// protoc-gen-go output for the fixture messages
+func TestTypeCheck(t *testing.T) {
+	if testing.Short() {
+		t.Skip("type checking is slow")
+	}
+
+	fset := token.NewFileSet()
+	imp := importer.ForCompiler(fset, "source", nil)
+
+	for _, fix := range fixtures {
+		t.Run(fix.name, func(t *testing.T) {
+			plugin := newPlugin(t, fix)
+
+			// the messages of the dependencies are type checked first so the
+			// fixture can import them
+			deps := make(map[string]*types.Package)
+			for _, dep := range fix.deps {
+				depPlugin := newPlugin(t, fixture{file: dep})
+				for _, f := range depPlugin.Files {
+					if f.Generate {
+						internal_gengo.GenerateFile(depPlugin, f)
+						pkg := typeCheck(t, fset, importerFunc(imp.Import), string(f.GoImportPath), depPlugin.Response().File)
+						deps[pkg.Path()] = pkg
+					}
+				}
+			}
+
+			for _, f := range plugin.Files {
+				if !f.Generate {
+					continue
+				}
+				internal_gengo.GenerateFile(plugin, f)
+			}
+
+			resp := generate(t, fix)
+			if resp.Error != nil {
+				t.Fatalf("generation failed: %s", resp.GetError())
+			}
+
+			// the test environments are in a sub package importing the
+			// fixture one, they are type checked last
+			generated := plugin.Response().File
+			testEnvs := make([]*pluginpb.CodeGeneratorResponse_File, 0)
+			for _, f := range resp.File {
+				if strings.HasSuffix(f.GetName(), "_tmprl_testenv.pb.go") {
+					testEnvs = append(testEnvs, f)
+					continue
+				}
+				generated = append(generated, f)
+			}
+
+			depsImporter := importerFunc(func(path string) (*types.Package, error) {
+				if pkg, ok := deps[path]; ok {
+					return pkg, nil
+				}
+				return imp.Import(path)
+			})
+
+			pkg := typeCheck(t, fset, depsImporter, "example.com/fixtures/v1", generated)
+			deps[pkg.Path()] = pkg
+
+			if len(testEnvs) > 0 {
+				typeCheck(t, fset, depsImporter, "example.com/fixtures/v1/fixturesv1test", testEnvs)
+			}
+		})
+	}
+}
+
+type importerFunc func(path string) (*types.Package, error)
+
+func (f importerFunc) Import(path string) (*types.Package, error) {
+	return f(path)
+}
+
+// typeCheck type checks the generated go files as the `path` package
+func typeCheck(t *testing.T, fset *token.FileSet, imp types.Importer, path string, generated []*pluginpb.CodeGeneratorResponse_File) *types.Package {
+	t.Helper()
+
+	files := make([]*ast.File, 0)
+	for _, f := range generated {
+		if !strings.HasSuffix(f.GetName(), ".go") {
+			continue
+		}
+
+		parsed, err := parser.ParseFile(fset, f.GetName(), f.GetContent(), 0)
+		if err != nil {
+			t.Fatalf("could not parse %s: %s", f.GetName(), err)
+		}
+		files = append(files, parsed)
+	}
+
+	conf := types.Config{Importer: imp}
+	pkg, err := conf.Check(path, fset, files, nil)
+	if err != nil {
+		t.Fatalf("generated code does not type check: %s", err)
+	}
+
+	return pkg
+}
@@ -0,0 +1,131 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func getActivityErrorName(service *protogen.Service, method *protogen.Method) string {
+	return fmt.Sprintf("%s%sActivityError", service.GoName, method.GoName)
+}
+
+func getNewActivityErrorName(service *protogen.Service, method *protogen.Method) string {
+	return fmt.Sprintf("new%s%sActivityError", service.GoName, method.GoName)
+}
+
+// hasHeartbeatDetails returns true if the activity records typed heartbeat details
+func hasHeartbeatDetails(method *protogen.Method) bool {
+	return getActivityOptions(method).GetHeartbeatDetails() != ""
+}
+
+func ServiceHeartbeats(gf *protogen.GeneratedFile, service *protogen.Service, config *Config) error {
+	heartbeats := jen.Null()
+	found := false
+
+	for _, method := range service.Methods {
+		t, err := getMethodType(method)
+		if err != nil {
+			return err
+		}
+
+		if t != MethodTypeActivity || !hasHeartbeatDetails(method) {
+			continue
+		}
+
+		details, err := config.registry.findMessage(service, getActivityOptions(method).GetHeartbeatDetails())
+		if err != nil {
+			return fmt.Errorf("invalid heartbeat details for activity %s: %w", method.Desc.FullName(), err)
+		}
+
+		found = true
+		detailsType := gf.QualifiedGoIdent(details.GoIdent)
+		errorName := getActivityErrorName(service, method)
+
+		/*
+			Activity side functions
+		*/
+		heartbeats.Comment(fmt.Sprintf("RecordHeartbeat%s records a heartbeat of the %s activity with typed details", method.GoName, method.GoName)).Line().
+			Comment("This is called within your activity to checkpoint its progress").Line().
+			Func().Id(fmt.Sprintf("RecordHeartbeat%s", method.GoName)).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Id("ctx").Id(getContext(gf)))
+			g.Add(jen.Id("details").Op("*").Id(detailsType))
+		}).BlockFunc(func(g *jen.Group) {
+			g.Add(jen.Id(getTemporalActivityObject(gf, "RecordHeartbeat")).Call(jen.Id("ctx"), jen.Id("details")))
+		}).Line()
+
+		heartbeats.Comment(fmt.Sprintf("GetHeartbeatDetails%s returns the details recorded by the previous attempt of the %s activity", method.GoName, method.GoName)).Line().
+			Comment("The boolean is false if there are none, for example on the first attempt").Line().
+			Func().Id(fmt.Sprintf("GetHeartbeatDetails%s", method.GoName)).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Id("ctx").Id(getContext(gf)))
+		}).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Op("*").Id(detailsType))
+			g.Add(jen.Bool())
+		}).BlockFunc(func(g *jen.Group) {
+			g.Add(jen.If(jen.Op("!").Id(getTemporalActivityObject(gf, "HasHeartbeatDetails")).Call(jen.Id("ctx"))).Block(
+				jen.Return(jen.Nil(), jen.False()),
+			))
+
+			g.Add(jen.Var().Id("details").Op("*").Id(detailsType))
+			g.Add(jen.If(jen.Err().Op(":=").Id(getTemporalActivityObject(gf, "GetHeartbeatDetails")).Call(jen.Id("ctx"), jen.Op("&").Id("details")), jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Nil(), jen.False()),
+			))
+
+			g.Add(jen.Return(jen.Id("details"), jen.True()))
+		}).Line()
+
+		/*
+			Typed error returned by the synchronous executions
+		*/
+		heartbeats.Comment(fmt.Sprintf("%s is the error returned by ExecuteActivity%sSync when the activity fails", errorName, method.GoName)).Line().
+			Type().Id(errorName).StructFunc(func(g *jen.Group) {
+			g.Add(jen.Comment("LastHeartbeatDetails are the last details recorded before the activity timed out, nil if there are none"))
+			g.Add(jen.Id("LastHeartbeatDetails").Op("*").Id(detailsType))
+			g.Add(jen.Id("err").Error())
+		}).Line()
+
+		heartbeats.Comment("Error returns the message of the underlying error").Line().
+			Func().Parens(jen.Id("e").Op("*").Id(errorName)).Id("Error").Params().String().Block(
+			jen.Return(jen.Id("e").Dot("err").Dot("Error").Call()),
+		).Line()
+
+		heartbeats.Comment("Unwrap returns the underlying error").Line().
+			Func().Parens(jen.Id("e").Op("*").Id(errorName)).Id("Unwrap").Params().Error().Block(
+			jen.Return(jen.Id("e").Dot("err")),
+		).Line()
+
+		heartbeats.Comment(fmt.Sprintf("%s wraps an error of the %s activity, extracting its last heartbeat details", getNewActivityErrorName(service, method), method.GoName)).Line().
+			Func().Id(getNewActivityErrorName(service, method)).Params(jen.Err().Error()).Error().BlockFunc(func(g *jen.Group) {
+			g.Add(jen.Id("activityErr").Op(":=").Op("&").Id(errorName).Values(jen.Dict{
+				jen.Id("err"): jen.Err(),
+			}))
+
+			g.Add(jen.Var().Id("timeoutErr").Op("*").Id(getTemporalObject(gf, "TimeoutError")))
+			g.Add(jen.If(
+				jen.Id(getErrorsObject(gf, "As")).Call(jen.Err(), jen.Op("&").Id("timeoutErr")).Op("&&").Id("timeoutErr").Dot("HasLastHeartbeatDetails").Call(),
+			).BlockFunc(func(g *jen.Group) {
+				g.Add(jen.Var().Id("details").Op("*").Id(detailsType))
+				g.Add(jen.If(jen.Id("timeoutErr").Dot("LastHeartbeatDetails").Call(jen.Op("&").Id("details")).Op("==").Nil()).Block(
+					jen.Id("activityErr").Dot("LastHeartbeatDetails").Op("=").Id("details"),
+				))
+			}))
+
+			g.Add(jen.Return(jen.Id("activityErr")))
+		}).Line()
+	}
+
+	if !found {
+		return nil
+	}
+
+	buf := bytes.NewBufferString("")
+	if err := heartbeats.Render(buf); err != nil {
+		return err
+	}
+
+	gf.P(buf.String())
+
+	return nil
+}
@@ -4,18 +4,35 @@ import (
 	"fmt"
 
 	"github.com/dave/jennifer/jen"
+	temporalv1 "github.com/thomas-maurice/protoc-gen-go-tmprl/gen/temporal/v1"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 const (
-	workflowImport = "go.temporal.io/sdk/workflow"
-	activityImport = "go.temporal.io/sdk/activity"
-	workerImport   = "go.temporal.io/sdk/worker"
-	clientImport   = "go.temporal.io/sdk/client"
-	temporalImport = "go.temporal.io/sdk/temporal"
-	internalImport = "go.temporal.io/sdk/internal"
-	uuidImport     = "github.com/google/uuid"
-	fmtImport      = "fmt"
+	workflowImport  = "go.temporal.io/sdk/workflow"
+	activityImport  = "go.temporal.io/sdk/activity"
+	workerImport    = "go.temporal.io/sdk/worker"
+	clientImport    = "go.temporal.io/sdk/client"
+	temporalImport  = "go.temporal.io/sdk/temporal"
+	internalImport  = "go.temporal.io/sdk/internal"
+	enumsImport     = "go.temporal.io/api/enums/v1"
+	converterImport = "go.temporal.io/sdk/converter"
+	uuidImport      = "github.com/google/uuid"
+	fmtImport       = "fmt"
+	errorsImport    = "errors"
+	protoreflImport = "google.golang.org/protobuf/reflect/protoreflect"
+	protoImport     = "google.golang.org/protobuf/proto"
+	commonImport    = "go.temporal.io/api/common/v1"
+	testsuiteImport = "go.temporal.io/sdk/testsuite"
+	mockImport      = "github.com/stretchr/testify/mock"
+	testingImport   = "testing"
+	debugImport     = "runtime/debug"
+	aesImport       = "crypto/aes"
+	cipherImport    = "crypto/cipher"
+	randImport      = "crypto/rand"
+	ioImport        = "io"
+	httpImport      = "net/http"
 )
 
 var (
@@ -105,6 +122,15 @@ func getFmtObject(gf *protogen.GeneratedFile, o string) string {
 	)
 }
 
+func getErrorsObject(gf *protogen.GeneratedFile, o string) string {
+	return gf.QualifiedGoIdent(
+		protogen.GoIdent{
+			GoImportPath: errorsImport,
+			GoName:       o,
+		},
+	)
+}
+
 func getTemporalWorkflowObject(gf *protogen.GeneratedFile, o string) string {
 	return gf.QualifiedGoIdent(
 		protogen.GoIdent{
@@ -123,6 +149,188 @@ func getTemporalActivityObject(gf *protogen.GeneratedFile, o string) string {
 	)
 }
 
+func getTemporalEnumsObject(gf *protogen.GeneratedFile, o string) string {
+	return gf.QualifiedGoIdent(
+		protogen.GoIdent{
+			GoImportPath: enumsImport,
+			GoName:       o,
+		},
+	)
+}
+
+func getTemporalConverterObject(gf *protogen.GeneratedFile, o string) string {
+	return gf.QualifiedGoIdent(
+		protogen.GoIdent{
+			GoImportPath: converterImport,
+			GoName:       o,
+		},
+	)
+}
+
+func getTestingObject(gf *protogen.GeneratedFile, o string) string {
+	return gf.QualifiedGoIdent(
+		protogen.GoIdent{
+			GoImportPath: testingImport,
+			GoName:       o,
+		},
+	)
+}
+
+func getProtoObject(gf *protogen.GeneratedFile, o string) string {
+	return gf.QualifiedGoIdent(
+		protogen.GoIdent{
+			GoImportPath: protoImport,
+			GoName:       o,
+		},
+	)
+}
+
+func getProtoreflectObject(gf *protogen.GeneratedFile, o string) string {
+	return gf.QualifiedGoIdent(
+		protogen.GoIdent{
+			GoImportPath: protoreflImport,
+			GoName:       o,
+		},
+	)
+}
+
+// fieldGoType returns the Go type of the value returned by the getter of a field
+func fieldGoType(gf *protogen.GeneratedFile, field *protogen.Field) *jen.Statement {
+	switch {
+	case field.Desc.IsMap():
+		return jen.Map(fieldGoType(gf, field.Message.Fields[0])).Add(fieldGoType(gf, field.Message.Fields[1]))
+	case field.Desc.IsList():
+		return jen.Index().Add(scalarGoType(gf, field))
+	}
+
+	return scalarGoType(gf, field)
+}
+
+// scalarGoType returns the Go type of a single value of a field
+func scalarGoType(gf *protogen.GeneratedFile, field *protogen.Field) *jen.Statement {
+	switch field.Desc.Kind() {
+	case protoreflect.BoolKind:
+		return jen.Bool()
+	case protoreflect.EnumKind:
+		return jen.Id(gf.QualifiedGoIdent(field.Enum.GoIdent))
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return jen.Int32()
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return jen.Uint32()
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return jen.Int64()
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return jen.Uint64()
+	case protoreflect.FloatKind:
+		return jen.Float32()
+	case protoreflect.DoubleKind:
+		return jen.Float64()
+	case protoreflect.StringKind:
+		return jen.String()
+	case protoreflect.BytesKind:
+		return jen.Index().Byte()
+	}
+
+	return jen.Op("*").Id(gf.QualifiedGoIdent(field.Message.GoIdent))
+}
+
 func getSvcName(svc *protogen.Service) string {
 	return fmt.Sprintf("%sService", svc.GoName)
 }
+
+func getRegisterServiceName(svc *protogen.Service) string {
+	return fmt.Sprintf("Register%sService", svc.GoName)
+}
+
+// secondsDuration returns a time.Duration statement of the given amount of seconds
+func secondsDuration(gf *protogen.GeneratedFile, seconds int32) *jen.Statement {
+	return jen.Id(getTimeObject(gf, "Duration")).Call(jen.Lit(int(seconds))).Op("*").Id(getTimeObject(gf, "Second"))
+}
+
+// retryPolicy returns a *temporal.RetryPolicy literal built from the proto options
+func retryPolicy(gf *protogen.GeneratedFile, rp *temporalv1.RetryPolicy) *jen.Statement {
+	return jen.Op("&").Id(getTemporalObject(gf, "RetryPolicy")).Values(jen.DictFunc(func(d jen.Dict) {
+		if rp.InitialIntervalDuration != nil {
+			d[jen.Id("InitialInterval")] = duration(gf, rp.InitialIntervalDuration)
+		}
+		if rp.MaximumIntervalDuration != nil {
+			d[jen.Id("MaximumInterval")] = duration(gf, rp.MaximumIntervalDuration)
+		}
+		if rp.BackoffCoefficient != nil {
+			d[jen.Id("BackoffCoefficient")] = jen.Float64().Call(jen.Lit(*rp.BackoffCoefficient))
+		}
+		if rp.MaximumAttempts != nil {
+			d[jen.Id("MaximumAttempts")] = jen.Lit(*rp.MaximumAttempts)
+		}
+		if rp.NonRetryableErrorTypes != nil {
+			d[jen.Id("NonRetryableErrorTypes")] = jen.Index().String().ValuesFunc(func(g *jen.Group) {
+				for _, errType := range rp.NonRetryableErrorTypes {
+					g.Lit(errType)
+				}
+			})
+		}
+	}))
+}
+
+func getTemporalCommonObject(gf *protogen.GeneratedFile, o string) string {
+	return gf.QualifiedGoIdent(
+		protogen.GoIdent{
+			GoImportPath: commonImport,
+			GoName:       o,
+		},
+	)
+}
+
+func getAESObject(gf *protogen.GeneratedFile, o string) string {
+	return gf.QualifiedGoIdent(
+		protogen.GoIdent{
+			GoImportPath: aesImport,
+			GoName:       o,
+		},
+	)
+}
+
+func getCipherObject(gf *protogen.GeneratedFile, o string) string {
+	return gf.QualifiedGoIdent(
+		protogen.GoIdent{
+			GoImportPath: cipherImport,
+			GoName:       o,
+		},
+	)
+}
+
+func getRandObject(gf *protogen.GeneratedFile, o string) string {
+	return gf.QualifiedGoIdent(
+		protogen.GoIdent{
+			GoImportPath: randImport,
+			GoName:       o,
+		},
+	)
+}
+
+func getIOObject(gf *protogen.GeneratedFile, o string) string {
+	return gf.QualifiedGoIdent(
+		protogen.GoIdent{
+			GoImportPath: ioImport,
+			GoName:       o,
+		},
+	)
+}
+
+func getHTTPObject(gf *protogen.GeneratedFile, o string) string {
+	return gf.QualifiedGoIdent(
+		protogen.GoIdent{
+			GoImportPath: httpImport,
+			GoName:       o,
+		},
+	)
+}
+
+func getDebugObject(gf *protogen.GeneratedFile, o string) string {
+	return gf.QualifiedGoIdent(
+		protogen.GoIdent{
+			GoImportPath: debugImport,
+			GoName:       o,
+		},
+	)
+}
@@ -0,0 +1,86 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// parseIDTemplate validates the `id_template` of a workflow against its input
+// message and returns the matching fmt format along with the referenced fields
+func parseIDTemplate(method *protogen.Method, template string) (string, []*protogen.Field, error) {
+	format := strings.Builder{}
+	fields := make([]*protogen.Field, 0)
+
+	rest := template
+	for len(rest) > 0 {
+		start := strings.IndexAny(rest, "{}")
+		if start < 0 {
+			format.WriteString(strings.ReplaceAll(rest, "%", "%%"))
+			break
+		}
+
+		if rest[start] == '}' {
+			return "", nil, fmt.Errorf("invalid id_template %q for workflow %s: unexpected '}'", template, method.Desc.FullName())
+		}
+
+		format.WriteString(strings.ReplaceAll(rest[:start], "%", "%%"))
+		rest = rest[start+1:]
+
+		end := strings.IndexAny(rest, "{}")
+		if end < 0 || rest[end] != '}' {
+			return "", nil, fmt.Errorf("invalid id_template %q for workflow %s: unclosed '{'", template, method.Desc.FullName())
+		}
+
+		name := rest[:end]
+		rest = rest[end+1:]
+
+		var field *protogen.Field
+		for _, f := range method.Input.Fields {
+			if string(f.Desc.Name()) == name {
+				field = f
+				break
+			}
+		}
+
+		if field == nil {
+			return "", nil, fmt.Errorf("invalid id_template %q for workflow %s: %s has no field %q", template, method.Desc.FullName(), method.Input.Desc.FullName(), name)
+		}
+
+		if field.Desc.Cardinality() == protoreflect.Repeated {
+			return "", nil, fmt.Errorf("invalid id_template %q for workflow %s: field %q is repeated", template, method.Desc.FullName(), name)
+		}
+
+		switch field.Desc.Kind() {
+		case protoreflect.MessageKind, protoreflect.GroupKind, protoreflect.BytesKind:
+			return "", nil, fmt.Errorf("invalid id_template %q for workflow %s: field %q is not a scalar", template, method.Desc.FullName(), name)
+		}
+
+		format.WriteString("%v")
+		fields = append(fields, field)
+	}
+
+	return format.String(), fields, nil
+}
+
+// workflowIDFromTemplate returns the statement building a workflow ID from the request variable `req`
+func workflowIDFromTemplate(gf *protogen.GeneratedFile, method *protogen.Method, template string, req string) (*jen.Statement, error) {
+	format, fields, err := parseIDTemplate(method, template)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fields) == 0 {
+		return jen.Lit(template), nil
+	}
+
+	return jen.Id(getFmtObject(gf, "Sprintf")).CallFunc(func(g *jen.Group) {
+		g.Add(jen.Lit(format))
+		for _, field := range fields {
+			g.Add(jen.Id(req).Dot(fmt.Sprintf("Get%s", field.GoName)).Call())
+		}
+	}), nil
+}
@@ -0,0 +1,127 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func ServiceLocalActivities(gf *protogen.GeneratedFile, service *protogen.Service, config *Config) error {
+	clientName := getClientName(service)
+
+	localActivities := jen.Null()
+	found := false
+
+	for _, method := range service.Methods {
+		t, err := getMethodType(method)
+		if err != nil {
+			return err
+		}
+
+		if t != MethodTypeActivity || !getActivityOptions(method).GetLocal() {
+			continue
+		}
+
+		found = true
+
+		methName, err := getMethodRegisteredName(method)
+		if err != nil {
+			return err
+		}
+
+		activityOptions := getMergedLocalActivityOptions(service, method)
+		activityOptions.LocalRetryPolicy, err = config.registry.expandRetryPolicy(service, activityOptions.LocalRetryPolicy)
+		if err != nil {
+			return fmt.Errorf("invalid local retry policy for %s: %w", method.Desc.FullName(), err)
+		}
+
+		// Executes the local activity asynchronously and sets a bunch of defaults
+		localActivities.Comment(fmt.Sprintf("ExecuteLocalActivity%s executes the activity as a local activity and returns a future to it", method.GoName)).Line().
+			Func().Parens(jen.Id("c").Op("*").Id(clientName)).Id(fmt.Sprintf("ExecuteLocalActivity%s", method.GoName)).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Id("ctx").Id(getTemporalWorkflowObject(gf, "Context")))
+			g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent)))
+			g.Add(jen.Id("options").Op("...").Id(getTemporalWorkflowObject(gf, "LocalActivityOptions")))
+		}).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Id(getTemporalWorkflowObject(gf, "Future")))
+		}).BlockFunc(func(g *jen.Group) {
+			if validatesBeforeSending(method, config) {
+				g.Add(validateRequestFuture(gf, service, methName, "req"))
+			}
+
+			g.Add(jen.Var().Id("aOptions").Id(getTemporalWorkflowObject(gf, "LocalActivityOptions")))
+			g.Add(jen.If(jen.Len(jen.Id("options")).Op(">").Lit(0).Block(
+				jen.Id("aOptions").Op("=").Id("options").Index(jen.Lit(0)),
+			)))
+
+			if activityOptions.LocalScheduleToCloseTimeoutDuration != nil {
+				g.Add(jen.If(jen.Id("aOptions").Dot("ScheduleToCloseTimeout").Op("==").Lit(0)).Block(
+					jen.Id("aOptions").Dot("ScheduleToCloseTimeout").Op("=").Add(duration(gf, activityOptions.LocalScheduleToCloseTimeoutDuration)),
+				))
+			}
+
+			if activityOptions.LocalStartToCloseTimeoutDuration != nil {
+				g.Add(jen.If(jen.Id("aOptions").Dot("StartToCloseTimeout").Op("==").Lit(0)).Block(
+					jen.Id("aOptions").Dot("StartToCloseTimeout").Op("=").Add(duration(gf, activityOptions.LocalStartToCloseTimeoutDuration)),
+				))
+			}
+
+			// local activities need at least one of the timeouts
+			g.Add(jen.If(jen.Id("aOptions").Dot("ScheduleToCloseTimeout").Op("==").Lit(0).Op("&&").Id("aOptions").Dot("StartToCloseTimeout").Op("==").Lit(0)).Block(
+				jen.Id("aOptions").Dot("ScheduleToCloseTimeout").Op("=").Id(getTimeObject(gf, "Duration")).Call(
+					jen.Id(fmt.Sprintf("Default%sActivityScheduleToCloseTimeout", service.GoName)),
+				).Op("*").Id(getTimeObject(gf, "Second")),
+			))
+
+			if activityOptions.LocalRetryPolicy != nil {
+				g.Add(jen.If(jen.Id("aOptions").Dot("RetryPolicy").Op("==").Nil()).Block(
+					jen.Id("aOptions").Dot("RetryPolicy").Op("=").Add(retryPolicy(gf, activityOptions.LocalRetryPolicy)),
+				))
+			}
+
+			g.Add(jen.Return(jen.Id(getTemporalWorkflowObject(gf, "ExecuteLocalActivity")).Call(
+				jen.Id(getTemporalWorkflowObject(gf, "WithLocalActivityOptions")).Call(jen.Id("ctx"), jen.Id("aOptions")),
+				jen.Lit(methName),
+				jen.Id("req"),
+			)))
+		}).Line().Line()
+
+		// Executes the local activity synchronously
+		localActivities.Comment(fmt.Sprintf("ExecuteLocalActivity%sSync executes the activity as a local activity and returns the result when finished", method.GoName)).Line().
+			Func().Parens(jen.Id("c").Op("*").Id(clientName)).Id(fmt.Sprintf("ExecuteLocalActivity%sSync", method.GoName)).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Id("ctx").Id(getTemporalWorkflowObject(gf, "Context")))
+			g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent)))
+			g.Add(jen.Id("options").Op("...").Id(getTemporalWorkflowObject(gf, "LocalActivityOptions")))
+		}).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Op("*").Id(gf.QualifiedGoIdent(method.Output.GoIdent)))
+			g.Add(jen.Error())
+		}).BlockFunc(func(g *jen.Group) {
+			g.Add(jen.Id("future").Op(":=").Id("c").Dot(fmt.Sprintf("ExecuteLocalActivity%s", method.GoName)).Call(
+				jen.Id("ctx"),
+				jen.Id("req"),
+				jen.Id("options").Op("..."),
+			))
+
+			g.Add(jen.Var().Id("resp").Op("*").Id(gf.QualifiedGoIdent(method.Output.GoIdent)))
+			g.Add(jen.Id("err").Op(":=").Id("future").Dot("Get").Call(jen.Id("ctx"), jen.Op("&").Id("resp")))
+
+			g.Add(IfErrNilDouble)
+
+			g.Add(jen.Return(jen.Id("resp"), jen.Nil()))
+		}).Line().Line()
+	}
+
+	if !found {
+		return nil
+	}
+
+	buf := bytes.NewBufferString("")
+	if err := localActivities.Render(buf); err != nil {
+		return err
+	}
+
+	gf.P(buf.String())
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	temporalv1 "github.com/thomas-maurice/protoc-gen-go-tmprl/gen/temporal/v1"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+)
+
+// memoField is a field of a workflow input copied to the memo of the workflow
+type memoField struct {
+	key   string
+	field *protogen.Field
+}
+
+func getMemoName(service *protogen.Service, method *protogen.Method) string {
+	return fmt.Sprintf("%s%sMemo", service.GoName, method.GoName)
+}
+
+func getMemoFieldsName(service *protogen.Service, method *protogen.Method) string {
+	return fmt.Sprintf("%s%sMemoFields", service.GoName, method.GoName)
+}
+
+// getMemoFields returns the memo fields declared on the fields of a message
+func getMemoFields(message *protogen.Message) ([]*memoField, error) {
+	fields := make([]*memoField, 0)
+	keys := make(map[string]*protogen.Field)
+
+	for _, field := range message.Fields {
+		opts, _ := proto.GetExtension(field.Desc.Options(), temporalv1.E_Memo).(*temporalv1.MemoOptions)
+		if opts == nil {
+			continue
+		}
+
+		key := opts.GetKey()
+		if key == "" {
+			key = field.GoName
+		}
+
+		if other, ok := keys[key]; ok {
+			return nil, fmt.Errorf("memo key %q is used by both %s and %s", key, other.Desc.FullName(), field.Desc.FullName())
+		}
+		keys[key] = field
+
+		fields = append(fields, &memoField{
+			key:   key,
+			field: field,
+		})
+	}
+
+	return fields, nil
+}
+
+// hasMemo returns true if the input of the method declares memo fields,
+// invalid declarations are reported by ServiceMemos
+func hasMemo(method *protogen.Method) bool {
+	fields, err := getMemoFields(method.Input)
+	return err == nil && len(fields) != 0
+}
+
+func ServiceMemos(gf *protogen.GeneratedFile, service *protogen.Service) error {
+	memos := jen.Null()
+	found := false
+
+	for _, method := range service.Methods {
+		t, err := getMethodType(method)
+		if err != nil {
+			return err
+		}
+
+		if t != MethodTypeWorkflow {
+			continue
+		}
+
+		fields, err := getMemoFields(method.Input)
+		if err != nil {
+			return err
+		}
+
+		if len(fields) == 0 {
+			continue
+		}
+
+		found = true
+		memoName := getMemoName(service, method)
+		memoFieldsName := getMemoFieldsName(service, method)
+
+		memos.Comment(fmt.Sprintf("%s is the memo of the %s workflow", memoName, method.GoName)).Line().
+			Type().Id(memoName).StructFunc(func(g *jen.Group) {
+			for _, f := range fields {
+				g.Add(jen.Id(f.field.GoName).Add(fieldGoType(gf, f.field)))
+			}
+		}).Line()
+
+		memos.Comment(fmt.Sprintf("%s returns the memo derived from a %s request", memoFieldsName, method.GoName)).Line().
+			Func().Id(memoFieldsName).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent)))
+		}).Map(jen.String()).Interface().BlockFunc(func(g *jen.Group) {
+			g.Add(jen.Return(jen.Map(jen.String()).Interface().Values(jen.DictFunc(func(d jen.Dict) {
+				for _, f := range fields {
+					d[jen.Lit(f.key)] = jen.Id("req").Dot(fmt.Sprintf("Get%s", f.field.GoName)).Call()
+				}
+			}))))
+		}).Line()
+	}
+
+	if !found {
+		return nil
+	}
+
+	buf := bytes.NewBufferString("")
+	if err := memos.Render(buf); err != nil {
+		return err
+	}
+
+	gf.P(buf.String())
+
+	return nil
+}
+
+// memoDefault returns the statement setting the memo of `options` from
+// the request when the caller did not provide any
+func memoDefault(service *protogen.Service, method *protogen.Method, options *jen.Statement, req string) *jen.Statement {
+	return jen.If(options.Clone().Dot("Memo").Op("==").Nil()).Block(
+		options.Clone().Dot("Memo").Op("=").Id(getMemoFieldsName(service, method)).Call(jen.Id(req)),
+	)
+}
+
+// workflowMemo returns the Memo method of the workflow object, decoding
+// the memo of the execution with the data converter of the client
+func workflowMemo(gf *protogen.GeneratedFile, service *protogen.Service, method *protogen.Method, config *Config) (*jen.Statement, error) {
+	fields, err := getMemoFields(method.Input)
+	if err != nil {
+		return nil, err
+	}
+
+	memoName := getMemoName(service, method)
+
+	// the temporal client does not expose its data converter
+	doc := "default one is used if omitted"
+	if config.ProtoConverter != "" {
+		doc = fmt.Sprintf("one New%sWithProtoConverter dialed with, if any, or the default one is used if omitted", getClientName(service))
+	}
+
+	return jen.Comment("Memo fetches the memo of the workflow and decodes it").Line().
+		Comment("The fields missing from the memo are left to their zero value").Line().
+		Comment("`dataConverter` must be the one the temporal client was created with, the").Line().
+		Comment(doc).Line().
+		Func().Parens(jen.Id("w").Op("*").Id(getWorkflowObjectName(service, method))).Id("Memo").ParamsFunc(func(g *jen.Group) {
+		g.Add(jen.Id("ctx").Id(getContext(gf)))
+		g.Add(jen.Id("dataConverter").Op("...").Id(getTemporalConverterObject(gf, "DataConverter")))
+	}).ParamsFunc(func(g *jen.Group) {
+		g.Add(jen.Op("*").Id(memoName))
+		g.Add(jen.Error())
+	}).BlockFunc(func(g *jen.Group) {
+		g.Add(jen.List(jen.Id("resp"), jen.Err()).Op(":=").Id("w").Dot("client").Dot("DescribeWorkflowExecution").Call(
+			jen.Id("ctx"),
+			jen.Id("w").Dot("workflowId"),
+			jen.Id("w").Dot("runId"),
+		))
+		g.Add(IfErrNilDouble)
+
+		g.Add(jen.Id("dc").Op(":=").Id(getTemporalConverterObject(gf, "GetDefaultDataConverter")).Call())
+		if config.ProtoConverter != "" {
+			g.Add(jen.If(jen.Id("w").Dot("c").Dot("dataConverter").Op("!=").Nil()).Block(
+				jen.Id("dc").Op("=").Id("w").Dot("c").Dot("dataConverter"),
+			))
+		}
+		g.Add(jen.If(jen.Len(jen.Id("dataConverter")).Op(">").Lit(0)).Block(
+			jen.Id("dc").Op("=").Id("dataConverter").Index(jen.Lit(0)),
+		))
+		g.Add(jen.Id("fields").Op(":=").Id("resp").Dot("GetWorkflowExecutionInfo").Call().Dot("GetMemo").Call().Dot("GetFields").Call())
+		g.Add(jen.Id("memo").Op(":=").Op("&").Id(memoName).Values())
+
+		for _, f := range fields {
+			g.Add(jen.If(
+				jen.List(jen.Id("payload"), jen.Id("ok")).Op(":=").Id("fields").Index(jen.Lit(f.key)),
+				jen.Id("ok"),
+			).Block(
+				jen.If(
+					jen.Err().Op(":=").Id("dc").Dot("FromPayload").Call(jen.Id("payload"), jen.Op("&").Id("memo").Dot(f.field.GoName)),
+					jen.Err().Op("!=").Nil(),
+				).Block(
+					jen.Return(jen.Nil(), jen.Id(getFmtObject(gf, "Errorf")).Call(jen.Lit(fmt.Sprintf("could not decode the %s memo: %%w", f.key)), jen.Err())),
+				),
+			))
+		}
+
+		g.Add(jen.Return(jen.Id("memo"), jen.Nil()))
+	}).Line().Line(), nil
+}
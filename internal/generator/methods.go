@@ -15,6 +15,7 @@ const (
 	MethodTypeActivity = MethodType("ACTIVITY")
 	MethodTypeSignal   = MethodType("SIGNAL")
 	MethodTypeQuery    = MethodType("QUERY")
+	MethodTypeUpdate   = MethodType("UPDATE")
 	MethodTypeNone     = MethodType("NONE")
 	MethodTypeInvalid  = MethodType("INVALID")
 )
@@ -24,8 +25,9 @@ func getMethodType(m *protogen.Method) (MethodType, error) {
 	act, _ := proto.GetExtension(m.Desc.Options(), temporalv1.E_Activity).(*temporalv1.ActivityOptions)
 	sig, _ := proto.GetExtension(m.Desc.Options(), temporalv1.E_Signal).(*temporalv1.SignalOptions)
 	query, _ := proto.GetExtension(m.Desc.Options(), temporalv1.E_Query).(*temporalv1.QueryOptions)
+	update, _ := proto.GetExtension(m.Desc.Options(), temporalv1.E_Update).(*temporalv1.UpdateOptions)
 
-	if wf == nil && act == nil && sig == nil && query == nil {
+	if wf == nil && act == nil && sig == nil && query == nil && update == nil {
 		return MethodTypeNone, nil
 	}
 
@@ -45,6 +47,10 @@ func getMethodType(m *protogen.Method) (MethodType, error) {
 		return MethodTypeQuery, nil
 	}
 
+	if update != nil {
+		return MethodTypeUpdate, nil
+	}
+
 	return MethodTypeWorkflow, nil
 }
 
@@ -53,8 +59,9 @@ func getMethodRegisteredName(m *protogen.Method) (string, error) {
 	act, _ := proto.GetExtension(m.Desc.Options(), temporalv1.E_Activity).(*temporalv1.ActivityOptions)
 	sig, _ := proto.GetExtension(m.Desc.Options(), temporalv1.E_Signal).(*temporalv1.SignalOptions)
 	query, _ := proto.GetExtension(m.Desc.Options(), temporalv1.E_Query).(*temporalv1.QueryOptions)
+	update, _ := proto.GetExtension(m.Desc.Options(), temporalv1.E_Update).(*temporalv1.UpdateOptions)
 
-	if wf == nil && act == nil && sig == nil && query == nil {
+	if wf == nil && act == nil && sig == nil && query == nil && update == nil {
 		return "", nil
 	}
 
@@ -94,6 +101,14 @@ func getMethodRegisteredName(m *protogen.Method) (string, error) {
 		}
 	}
 
+	if update != nil {
+		if update.Name != "" {
+			return update.Name, nil
+		} else {
+			return string(m.Desc.FullName()), nil
+		}
+	}
+
 	return "", nil
 }
 
@@ -103,7 +118,7 @@ func getActivityOptions(m *protogen.Method) *temporalv1.ActivityOptions {
 		// nothing is set
 		return nil
 	}
-	return act
+	return normalizeActivityOptions(act)
 }
 
 func getWorkflowOptions(m *protogen.Method) *temporalv1.WorkflowOptions {
@@ -112,7 +127,7 @@ func getWorkflowOptions(m *protogen.Method) *temporalv1.WorkflowOptions {
 		// nothing is set
 		return nil
 	}
-	return wf
+	return normalizeWorkflowOptions(wf)
 }
 
 func getDefaultActivityOptions(m *protogen.Service) *temporalv1.ActivityOptions {
@@ -121,7 +136,7 @@ func getDefaultActivityOptions(m *protogen.Service) *temporalv1.ActivityOptions
 		return nil
 	}
 
-	return svcOpts.DefaultActivityOptions
+	return normalizeActivityOptions(svcOpts.DefaultActivityOptions)
 }
 
 func getDefaultWorkflowOptions(m *protogen.Service) *temporalv1.WorkflowOptions {
@@ -130,5 +145,93 @@ func getDefaultWorkflowOptions(m *protogen.Service) *temporalv1.WorkflowOptions
 		return nil
 	}
 
-	return svcOpts.DefaultWorkflowOptions
+	return normalizeWorkflowOptions(svcOpts.DefaultWorkflowOptions)
+}
+
+// getMergedWorkflowOptions returns the options of a workflow completed
+// with the timeouts, retry policy, ID policies, continue-as-new
+// thresholds and child policies of the service defaults
+func getMergedWorkflowOptions(service *protogen.Service, m *protogen.Method) *temporalv1.WorkflowOptions {
+	opts := &temporalv1.WorkflowOptions{}
+	if wf := getWorkflowOptions(m); wf != nil {
+		opts = proto.Clone(wf).(*temporalv1.WorkflowOptions)
+	}
+
+	defaults := getDefaultWorkflowOptions(service)
+	if defaults == nil {
+		return opts
+	}
+
+	if opts.WorkflowExecutionTimeoutDuration == nil {
+		opts.WorkflowExecutionTimeoutDuration = defaults.WorkflowExecutionTimeoutDuration
+	}
+	if opts.WorkflowRunTimeoutDuration == nil {
+		opts.WorkflowRunTimeoutDuration = defaults.WorkflowRunTimeoutDuration
+	}
+	if opts.WorkflowTaskTimeoutDuration == nil {
+		opts.WorkflowTaskTimeoutDuration = defaults.WorkflowTaskTimeoutDuration
+	}
+	if opts.RetryPolicy == nil {
+		opts.RetryPolicy = defaults.RetryPolicy
+	}
+	if opts.IdReusePolicy == temporalv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
+		opts.IdReusePolicy = defaults.IdReusePolicy
+	}
+	if opts.IdConflictPolicy == temporalv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_UNSPECIFIED {
+		opts.IdConflictPolicy = defaults.IdConflictPolicy
+	}
+	if opts.ErrorWhenAlreadyStarted == nil {
+		opts.ErrorWhenAlreadyStarted = defaults.ErrorWhenAlreadyStarted
+	}
+	if opts.ContinueAsNewHistoryLength == nil {
+		opts.ContinueAsNewHistoryLength = defaults.ContinueAsNewHistoryLength
+	}
+	if opts.ContinueAsNewHistorySize == nil {
+		opts.ContinueAsNewHistorySize = defaults.ContinueAsNewHistorySize
+	}
+	if opts.ParentClosePolicy == temporalv1.ParentClosePolicy_PARENT_CLOSE_POLICY_UNSPECIFIED {
+		opts.ParentClosePolicy = defaults.ParentClosePolicy
+	}
+	if opts.WaitForCancellation == nil {
+		opts.WaitForCancellation = defaults.WaitForCancellation
+	}
+
+	return opts
+}
+
+// getMergedLocalActivityOptions returns the options of an activity run locally,
+// the local timeouts and retry policy falling back to the regular ones and then
+// to the service defaults
+func getMergedLocalActivityOptions(service *protogen.Service, m *protogen.Method) *temporalv1.ActivityOptions {
+	opts := &temporalv1.ActivityOptions{}
+	if act := getActivityOptions(m); act != nil {
+		opts = proto.Clone(act).(*temporalv1.ActivityOptions)
+	}
+
+	for _, fallback := range []*temporalv1.ActivityOptions{opts, getDefaultActivityOptions(service)} {
+		if fallback == nil {
+			continue
+		}
+
+		if opts.LocalScheduleToCloseTimeoutDuration == nil {
+			opts.LocalScheduleToCloseTimeoutDuration = fallback.LocalScheduleToCloseTimeoutDuration
+		}
+		if opts.LocalScheduleToCloseTimeoutDuration == nil {
+			opts.LocalScheduleToCloseTimeoutDuration = fallback.ScheduleToCloseTimeoutDuration
+		}
+		if opts.LocalStartToCloseTimeoutDuration == nil {
+			opts.LocalStartToCloseTimeoutDuration = fallback.LocalStartToCloseTimeoutDuration
+		}
+		if opts.LocalStartToCloseTimeoutDuration == nil {
+			opts.LocalStartToCloseTimeoutDuration = fallback.StartToCloseTimeoutDuration
+		}
+		if opts.LocalRetryPolicy == nil {
+			opts.LocalRetryPolicy = fallback.LocalRetryPolicy
+		}
+		if opts.LocalRetryPolicy == nil {
+			opts.LocalRetryPolicy = fallback.RetryPolicy
+		}
+	}
+
+	return opts
 }
@@ -0,0 +1,213 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func getServiceMiddlewareName(service *protogen.Service) string {
+	return fmt.Sprintf("%sMiddleware", getSvcName(service))
+}
+
+func getServiceWrapperName(service *protogen.Service) string {
+	return fmt.Sprintf("wrapped%s", getSvcName(service))
+}
+
+func getPanicErrorName(service *protogen.Service) string {
+	return fmt.Sprintf("%sPanicError", service.GoName)
+}
+
+// wrappedMethod returns the method of the wrapped service running the before
+// hooks of the middlewares, the implementation and then the after hooks in
+// reverse order. A panic is handed to the after hooks as a panic error and is
+// raised again unless one of them replaced it. The after hooks are skipped
+// when the goroutine exits without returning, as the SDK does with the
+// goroutines of the workflows evicted from the cache
+func wrappedMethod(gf *protogen.GeneratedFile, service *protogen.Service, method *protogen.Method, kind string, ctx string) (*jen.Statement, error) {
+	name, err := getMethodRegisteredName(method)
+	if err != nil {
+		return nil, err
+	}
+
+	panicErrorName := getPanicErrorName(service)
+	middlewares := jen.Id("s").Dot("middlewares")
+
+	return jen.Comment(fmt.Sprintf("%s runs the %s %s through the middlewares", method.GoName, method.GoName, strings.ToLower(kind))).Line().
+		Func().Parens(jen.Id("s").Op("*").Id(getServiceWrapperName(service))).Id(method.GoName).Params(
+		jen.Id("ctx").Id(ctx),
+		jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent)),
+	).Params(
+		jen.Id("resp").Op("*").Id(gf.QualifiedGoIdent(method.Output.GoIdent)),
+		jen.Err().Error(),
+	).BlockFunc(func(g *jen.Group) {
+		g.Add(jen.List(jen.Id("i"), jen.Id("returned")).Op(":=").List(jen.Lit(0), jen.False()))
+		g.Add(jen.Defer().Func().Params().Block(
+			jen.Id("r").Op(":=").Recover(),
+			jen.If(jen.Id("r").Op("==").Nil().Op("&&").Op("!").Id("returned")).Block(
+				jen.Comment("the goroutine exited without returning, nothing to hand to the hooks"),
+				jen.Return(),
+			),
+			jen.Var().Id("panicErr").Op("*").Id(panicErrorName),
+			jen.If(jen.Id("r").Op("!=").Nil()).Block(
+				jen.Id("panicErr").Op("=").Op("&").Id(panicErrorName).Values(jen.Dict{
+					jen.Id("Name"):  jen.Lit(name),
+					jen.Id("Value"): jen.Id("r"),
+					jen.Id("Stack"): jen.String().Call(jen.Id(getDebugObject(gf, "Stack")).Call()),
+				}),
+				jen.Err().Op("=").Id("panicErr"),
+			),
+			jen.Comment("a nil response is handed to the hooks as a nil message, not as a typed nil"),
+			jen.Var().Id("out").Id(getProtoObject(gf, "Message")),
+			jen.If(jen.Id("resp").Op("!=").Nil()).Block(
+				jen.Id("out").Op("=").Id("resp"),
+			),
+			jen.For(jen.Id("i").Op("--"), jen.Id("i").Op(">=").Lit(0), jen.Id("i").Op("--")).Block(
+				jen.Err().Op("=").Add(middlewares.Clone().Index(jen.Id("i"))).Dot("After"+kind).Call(
+					jen.Id("ctx"), jen.Lit(name), jen.Id("req"), jen.Id("out"), jen.Err(),
+				),
+			),
+			jen.If(jen.Id("panicErr").Op("!=").Nil().Op("&&").Err().Op("==").Id("panicErr")).Block(
+				jen.Panic(jen.Id("panicErr").Dot("Value")),
+			),
+		).Call())
+		g.Line()
+		g.Add(jen.For(jen.Id("i").Op("<").Len(middlewares.Clone())).Block(
+			jen.List(jen.Id("c"), jen.Err()).Op(":=").Add(middlewares.Clone().Index(jen.Id("i"))).Dot("Before"+kind).Call(
+				jen.Id("ctx"), jen.Lit(name), jen.Id("req"),
+			),
+			jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Id("returned").Op("=").True(),
+				jen.Return(jen.Nil(), jen.Err()),
+			),
+			jen.Id("ctx").Op("=").Id("c"),
+			jen.Id("i").Op("++"),
+		))
+		g.Line()
+		g.Add(jen.List(jen.Id("resp"), jen.Err()).Op("=").Id("s").Dot("svc").Dot(method.GoName).Call(jen.Id("ctx"), jen.Id("req")))
+		g.Add(jen.Id("returned").Op("=").True())
+		g.Add(jen.Return(jen.Id("resp"), jen.Err()))
+	}).Line().Line(), nil
+}
+
+// ServiceMiddleware generates the middlewares of the service implementations
+// and the function wrapping an implementation with them
+func ServiceMiddleware(gf *protogen.GeneratedFile, service *protogen.Service) error {
+	svcName := getSvcName(service)
+	middlewareName := getServiceMiddlewareName(service)
+	baseName := middlewareName + "Base"
+	wrapperName := getServiceWrapperName(service)
+	panicErrorName := getPanicErrorName(service)
+
+	message := jen.Id(getProtoObject(gf, "Message"))
+	ctx := jen.Id(getContext(gf))
+	wfCtx := jen.Id(getTemporalWorkflowObject(gf, "Context"))
+
+	middleware := jen.Null()
+
+	middleware.Comment(fmt.Sprintf("%s is called around the workflows and activities of a %s", middlewareName, svcName)).Line().
+		Comment(fmt.Sprintf("wrapped by Wrap%s, with their registered name, request and response.", svcName)).Line().
+		Comment("The workflow hooks run within the workflow, replays included, they must be deterministic").Line().
+		Comment("and only log through workflow.GetLogger or record metrics through workflow.GetMetricsHandler").Line().
+		Type().Id(middlewareName).Interface(
+		jen.Comment("BeforeActivity is called before an activity runs, returning an error skips it"),
+		jen.Id("BeforeActivity").Params(jen.Id("ctx").Add(ctx), jen.Id("name").String(), jen.Id("req").Add(message)).Params(ctx, jen.Error()),
+		jen.Comment("AfterActivity is called once an activity returned, `resp` being nil if it returned none, and returns the error to return instead"),
+		jen.Id("AfterActivity").Params(jen.Id("ctx").Add(ctx), jen.Id("name").String(), jen.Id("req").Add(message), jen.Id("resp").Add(message), jen.Err().Error()).Error(),
+		jen.Comment("BeforeWorkflow is called before a workflow runs, returning an error skips it"),
+		jen.Id("BeforeWorkflow").Params(jen.Id("ctx").Add(wfCtx), jen.Id("name").String(), jen.Id("req").Add(message)).Params(wfCtx, jen.Error()),
+		jen.Comment("AfterWorkflow is called once a workflow returned, `resp` being nil if it returned none, and returns the error to return instead"),
+		jen.Id("AfterWorkflow").Params(jen.Id("ctx").Add(wfCtx), jen.Id("name").String(), jen.Id("req").Add(message), jen.Id("resp").Add(message), jen.Err().Error()).Error(),
+	).Line().Line()
+
+	middleware.Comment(fmt.Sprintf("%s is a %s doing nothing,", baseName, middlewareName)).Line().
+		Comment("to be embedded by the middlewares only implementing some of the hooks").Line().
+		Type().Id(baseName).Struct().Line().Line()
+
+	middleware.Comment("BeforeActivity returns the context as is").Line().
+		Func().Parens(jen.Id(baseName)).Id("BeforeActivity").Params(jen.Id("ctx").Add(ctx), jen.Id("name").String(), jen.Id("req").Add(message)).Params(ctx, jen.Error()).Block(
+		jen.Return(jen.Id("ctx"), jen.Nil()),
+	).Line().Line()
+
+	middleware.Comment("AfterActivity returns the error as is").Line().
+		Func().Parens(jen.Id(baseName)).Id("AfterActivity").Params(jen.Id("ctx").Add(ctx), jen.Id("name").String(), jen.Id("req").Add(message), jen.Id("resp").Add(message), jen.Err().Error()).Error().Block(
+		jen.Return(jen.Err()),
+	).Line().Line()
+
+	middleware.Comment("BeforeWorkflow returns the context as is").Line().
+		Func().Parens(jen.Id(baseName)).Id("BeforeWorkflow").Params(jen.Id("ctx").Add(wfCtx), jen.Id("name").String(), jen.Id("req").Add(message)).Params(wfCtx, jen.Error()).Block(
+		jen.Return(jen.Id("ctx"), jen.Nil()),
+	).Line().Line()
+
+	middleware.Comment("AfterWorkflow returns the error as is").Line().
+		Func().Parens(jen.Id(baseName)).Id("AfterWorkflow").Params(jen.Id("ctx").Add(wfCtx), jen.Id("name").String(), jen.Id("req").Add(message), jen.Id("resp").Add(message), jen.Err().Error()).Error().Block(
+		jen.Return(jen.Err()),
+	).Line().Line()
+
+	middleware.Comment(fmt.Sprintf("%s is handed to the after hooks when a workflow or an activity of the", panicErrorName)).Line().
+		Comment(fmt.Sprintf("%s service panics, the panic goes on unless a hook returns another error", service.GoName)).Line().
+		Type().Id(panicErrorName).Struct(
+		jen.Comment("Name is the registered name of the workflow or activity"),
+		jen.Id("Name").String(),
+		jen.Comment("Value is the value the panic was raised with"),
+		jen.Id("Value").Any(),
+		jen.Comment("Stack is the stack trace of the panic"),
+		jen.Id("Stack").String(),
+	).Line().Line()
+
+	middleware.Func().Parens(jen.Id("e").Op("*").Id(panicErrorName)).Id("Error").Params().String().Block(
+		jen.Return(jen.Id(getFmtObject(gf, "Sprintf")).Call(jen.Lit("panic in %s: %v"), jen.Id("e").Dot("Name"), jen.Id("e").Dot("Value"))),
+	).Line().Line()
+
+	middleware.Comment(fmt.Sprintf("%s is a %s running its methods through middlewares", wrapperName, svcName)).Line().
+		Type().Id(wrapperName).Struct(
+		jen.Id("svc").Id(svcName),
+		jen.Id("middlewares").Index().Id(middlewareName),
+	).Line().Line()
+
+	middleware.Comment(fmt.Sprintf("Wrap%s returns a %s calling the hooks of the middlewares around the", svcName, svcName)).Line().
+		Comment("workflows and activities of svc, the first middleware being the outermost one").Line().
+		Func().Id("Wrap"+svcName).Params(
+		jen.Id("svc").Id(svcName),
+		jen.Id("middlewares").Op("...").Id(middlewareName),
+	).Id(svcName).Block(
+		jen.Return(jen.Op("&").Id(wrapperName).Values(jen.Dict{
+			jen.Id("svc"):         jen.Id("svc"),
+			jen.Id("middlewares"): jen.Id("middlewares"),
+		})),
+	).Line().Line()
+
+	for _, method := range service.Methods {
+		t, err := getMethodType(method)
+		if err != nil {
+			return err
+		}
+
+		var wrapped *jen.Statement
+		switch t {
+		case MethodTypeWorkflow:
+			wrapped, err = wrappedMethod(gf, service, method, "Workflow", getTemporalWorkflowObject(gf, "Context"))
+		case MethodTypeActivity:
+			wrapped, err = wrappedMethod(gf, service, method, "Activity", getContext(gf))
+		default:
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		middleware.Add(wrapped)
+	}
+
+	buf := bytes.NewBufferString("")
+	if err := middleware.Render(buf); err != nil {
+		return err
+	}
+
+	gf.P(buf.String())
+
+	return nil
+}
@@ -0,0 +1,282 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func getDataConverterName(service *protogen.Service) string {
+	return fmt.Sprintf("New%sDataConverter", service.GoName)
+}
+
+// getProtoConverterEncodings returns the payload converters of the proto
+// messages in order of preference, and the encoding of the preferred one
+func getProtoConverterEncodings(config *Config) ([]string, string, error) {
+	switch config.ProtoConverter {
+	case ProtoConverterBinary:
+		return []string{"NewProtoPayloadConverter", "NewProtoJSONPayloadConverter"}, "MetadataEncodingProto", nil
+	case ProtoConverterJSON:
+		return []string{"NewProtoJSONPayloadConverter", "NewProtoPayloadConverter"}, "MetadataEncodingProtoJSON", nil
+	}
+
+	return nil, "", fmt.Errorf("unknown proto converter %q, it must be either %q or %q", config.ProtoConverter, ProtoConverterBinary, ProtoConverterJSON)
+}
+
+// getServiceMessages returns the input and output messages of the methods of a
+// service, each of them once
+func getServiceMessages(service *protogen.Service) []*protogen.Message {
+	messages := make([]*protogen.Message, 0)
+	seen := make(map[protoreflect.FullName]bool)
+
+	for _, method := range service.Methods {
+		for _, message := range []*protogen.Message{method.Input, method.Output} {
+			if seen[message.Desc.FullName()] {
+				continue
+			}
+
+			seen[message.Desc.FullName()] = true
+			messages = append(messages, message)
+		}
+	}
+
+	return messages
+}
+
+// ServiceProtoConverter generates the data converter of a service and the
+// client and worker constructors dialing temporal with it
+func ServiceProtoConverter(gf *protogen.GeneratedFile, service *protogen.Service, config *Config) error {
+	if config.ProtoConverter == "" {
+		return nil
+	}
+
+	converters, _, err := getProtoConverterEncodings(config)
+	if err != nil {
+		return err
+	}
+
+	clientName := getClientName(service)
+	workerName := fmt.Sprintf("%sWorker", service.GoName)
+	converterName := getDataConverterName(service)
+
+	protoConverter := jen.Null()
+
+	protoConverter.Comment(fmt.Sprintf("%s returns a data converter encoding the messages of the %s service as %s protobuf,", converterName, service.GoName, config.ProtoConverter)).Line().
+		Comment("it still decodes the messages encoded with the other protobuf encoding and falls back to JSON for other values.").Line().
+		Comment("The payloads go through the `codecs`, if any, like with converter.NewCodecDataConverter").Line().
+		Func().Id(converterName).Params(jen.Id("codecs").Op("...").Id(getTemporalConverterObject(gf, "PayloadCodec"))).Id(getTemporalConverterObject(gf, "DataConverter")).BlockFunc(func(g *jen.Group) {
+		payloadConverters := []jen.Code{
+			jen.Id(getTemporalConverterObject(gf, "NewNilPayloadConverter")).Call(),
+			jen.Id(getTemporalConverterObject(gf, "NewByteSlicePayloadConverter")).Call(),
+		}
+		for _, c := range converters {
+			payloadConverters = append(payloadConverters, jen.Id(getTemporalConverterObject(gf, c)).Call())
+		}
+		payloadConverters = append(payloadConverters, jen.Id(getTemporalConverterObject(gf, "NewJSONPayloadConverter")).Call())
+
+		g.Add(jen.Id("dataConverter").Op(":=").Id(getTemporalConverterObject(gf, "NewCompositeDataConverter")).Custom(
+			jen.Options{Open: "(", Close: ")", Separator: ",", Multi: true},
+			payloadConverters...,
+		))
+		g.Add(jen.If(jen.Len(jen.Id("codecs")).Op(">").Lit(0)).Block(
+			jen.Return(jen.Id(getTemporalConverterObject(gf, "NewCodecDataConverter")).Call(jen.Id("dataConverter"), jen.Id("codecs").Op("..."))),
+		))
+		g.Add(jen.Return(jen.Id("dataConverter")))
+	}).Line().Line()
+
+	// the data converter of the caller is kept, so payload codecs can be added
+	// with the converter of the service
+	dataConverterDefault := jen.If(jen.Id("opts").Dot("DataConverter").Op("==").Nil()).Block(
+		jen.Id("opts").Dot("DataConverter").Op("=").Id(converterName).Call(),
+	)
+
+	protoConverter.Comment(fmt.Sprintf("New%sWithProtoConverter dials temporal with the %s data converter and returns a client using it.", clientName, service.GoName)).Line().
+		Comment(fmt.Sprintf("A data converter set in `opts` is kept, %s(codecs...) adds payload codecs to the one of the service.", converterName)).Line().
+		Comment("If `taskQueue` stays empty the default one will be used").Line().
+		Func().Id(fmt.Sprintf("New%sWithProtoConverter", clientName)).ParamsFunc(func(g *jen.Group) {
+		g.Add(jen.Id("opts").Id(getTemporalClientObject(gf, "Options")))
+		g.Add(jen.Id("taskQueue").Op("...").String())
+	}).Params(jen.Op("*").Id(clientName), jen.Error()).BlockFunc(func(g *jen.Group) {
+		g.Add(dataConverterDefault.Clone())
+		g.Add(jen.List(jen.Id("c"), jen.Err()).Op(":=").Id(getTemporalClientObject(gf, "Dial")).Call(jen.Id("opts")))
+		g.Add(IfErrNilDouble)
+		g.Add(jen.List(jen.Id("svcClient"), jen.Err()).Op(":=").Id(fmt.Sprintf("New%s", clientName)).Call(jen.Id("c"), jen.Nil(), jen.Id("taskQueue").Op("...")))
+		g.Add(jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Id("c").Dot("Close").Call(),
+			jen.Return(jen.Nil(), jen.Err()),
+		))
+		g.Add(jen.Id("svcClient").Dot("dialed").Op("=").True())
+		g.Add(jen.Id("svcClient").Dot("dataConverter").Op("=").Id("opts").Dot("DataConverter"))
+		g.Add(jen.Return(jen.Id("svcClient"), jen.Nil()))
+	}).Line().Line()
+
+	protoConverter.Comment(fmt.Sprintf("Close closes the temporal client of the %s client if New%sWithProtoConverter dialed it,", service.GoName, clientName)).Line().
+		Comment("the clients given to the other constructors are left to their owner").Line().
+		Func().Parens(jen.Id("c").Op("*").Id(clientName)).Id("Close").Params().Block(
+		jen.If(jen.Id("c").Dot("dialed")).Block(
+			jen.Id("c").Dot("client").Dot("Close").Call(),
+		),
+	).Line().Line()
+
+	protoConverter.Comment(fmt.Sprintf("New%sWithProtoConverter dials temporal with the %s data converter and returns a worker using it.", workerName, service.GoName)).Line().
+		Comment(fmt.Sprintf("A data converter set in `opts` is kept, %s(codecs...) adds payload codecs to the one of the service.", converterName)).Line().
+		Comment("If `taskQueue` stays empty the default one will be used").Line().
+		Func().Id(fmt.Sprintf("New%sWithProtoConverter", workerName)).ParamsFunc(func(g *jen.Group) {
+		g.Add(jen.Id("opts").Id(getTemporalClientObject(gf, "Options")))
+		g.Add(jen.Id("svc").Id(getSvcName(service)))
+		g.Add(jen.Id("taskQueue").String())
+		g.Add(jen.Id("workerOptions").Op("...").Id(getTemporalWorkerObject(gf, "Options")))
+	}).Params(jen.Op("*").Id(workerName), jen.Error()).BlockFunc(func(g *jen.Group) {
+		g.Add(dataConverterDefault.Clone())
+		g.Add(jen.List(jen.Id("c"), jen.Err()).Op(":=").Id(getTemporalClientObject(gf, "Dial")).Call(jen.Id("opts")))
+		g.Add(IfErrNilDouble)
+		g.Add(jen.List(jen.Id("w"), jen.Err()).Op(":=").Id(fmt.Sprintf("New%s", workerName)).Call(jen.Id("c"), jen.Id("svc"), jen.Id("taskQueue"), jen.Id("workerOptions").Op("...")))
+		g.Add(jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Id("c").Dot("Close").Call(),
+			jen.Return(jen.Nil(), jen.Err()),
+		))
+		g.Add(jen.Id("w").Dot("dialed").Op("=").True())
+		g.Add(jen.Return(jen.Id("w"), jen.Nil()))
+	}).Line().Line()
+
+	protoConverter.Comment(fmt.Sprintf("Close closes the temporal client of the %s worker if New%sWithProtoConverter dialed it,", service.GoName, workerName)).Line().
+		Comment("once the worker is stopped. The clients given to the other constructors are left to their owner").Line().
+		Func().Parens(jen.Id("w").Op("*").Id(workerName)).Id("Close").Params().Block(
+		jen.If(jen.Id("w").Dot("dialed")).Block(
+			jen.Id("w").Dot("client").Dot("Close").Call(),
+		),
+	).Line()
+
+	buf := bytes.NewBufferString("")
+	if err := protoConverter.Render(buf); err != nil {
+		return err
+	}
+
+	gf.P(buf.String())
+
+	return nil
+}
+
+// fillMessage returns the closure setting all the fields of a message in the
+// generated tests, so the round trips are not only done on empty messages
+func fillMessage(gf *protogen.GeneratedFile) *jen.Statement {
+	kind := func(k string) *jen.Statement {
+		return jen.Id(getProtoreflectObject(gf, k))
+	}
+
+	value := func(f string, v jen.Code) *jen.Statement {
+		return jen.Id("v").Op("=").Id(getProtoreflectObject(gf, f)).Call(v)
+	}
+
+	return jen.Var().Id("fill").Func().Params(jen.Id("m").Id(getProtoreflectObject(gf, "Message")), jen.Id("depth").Int()).Line().
+		Id("fill").Op("=").Func().Params(jen.Id("m").Id(getProtoreflectObject(gf, "Message")), jen.Id("depth").Int()).BlockFunc(func(g *jen.Group) {
+		g.Add(jen.Id("fields").Op(":=").Id("m").Dot("Descriptor").Call().Dot("Fields").Call())
+		g.Add(jen.For(jen.Id("i").Op(":=").Lit(0), jen.Id("i").Op("<").Id("fields").Dot("Len").Call(), jen.Id("i").Op("++")).BlockFunc(func(g *jen.Group) {
+			g.Add(jen.Id("fd").Op(":=").Id("fields").Dot("Get").Call(jen.Id("i")))
+			g.Add(jen.If(jen.Id("fd").Dot("IsMap").Call().Op("||").Parens(
+				jen.Id("fd").Dot("ContainingOneof").Call().Op("!=").Nil().Op("&&").Id("m").Dot("WhichOneof").Call(jen.Id("fd").Dot("ContainingOneof").Call()).Op("!=").Nil(),
+			)).Block(jen.Continue()))
+
+			g.Add(jen.If(jen.Id("fd").Dot("Kind").Call().Op("==").Add(kind("MessageKind")).Op("||").Id("fd").Dot("Kind").Call().Op("==").Add(kind("GroupKind"))).BlockFunc(func(g *jen.Group) {
+				g.Comment("Any messages cannot be encoded without their type being resolved")
+				g.Add(jen.If(jen.Id("depth").Op(">=").Lit(3).Op("||").Id("fd").Dot("Message").Call().Dot("FullName").Call().Op("==").Lit("google.protobuf.Any")).Block(jen.Continue()))
+				g.Add(jen.If(jen.Id("fd").Dot("IsList").Call()).Block(
+					jen.Id("fill").Call(jen.Id("m").Dot("Mutable").Call(jen.Id("fd")).Dot("List").Call().Dot("AppendMutable").Call().Dot("Message").Call(), jen.Id("depth").Op("+").Lit(1)),
+				).Else().Block(
+					jen.Id("fill").Call(jen.Id("m").Dot("Mutable").Call(jen.Id("fd")).Dot("Message").Call(), jen.Id("depth").Op("+").Lit(1)),
+				))
+				g.Add(jen.Continue())
+			}))
+
+			g.Add(jen.Var().Id("v").Id(getProtoreflectObject(gf, "Value")))
+			g.Add(jen.Switch(jen.Id("fd").Dot("Kind").Call()).BlockFunc(func(g *jen.Group) {
+				g.Case(kind("BoolKind")).Add(value("ValueOfBool", jen.True()))
+				g.Case(kind("EnumKind")).Add(value("ValueOfEnum", jen.Id("fd").Dot("Enum").Call().Dot("Values").Call().Dot("Get").Call(
+					jen.Id("fd").Dot("Enum").Call().Dot("Values").Call().Dot("Len").Call().Op("-").Lit(1),
+				).Dot("Number").Call()))
+				g.Case(kind("Int32Kind"), kind("Sint32Kind"), kind("Sfixed32Kind")).Add(value("ValueOfInt32", jen.Lit(42)))
+				g.Case(kind("Int64Kind"), kind("Sint64Kind"), kind("Sfixed64Kind")).Add(value("ValueOfInt64", jen.Lit(42)))
+				g.Case(kind("Uint32Kind"), kind("Fixed32Kind")).Add(value("ValueOfUint32", jen.Lit(42)))
+				g.Case(kind("Uint64Kind"), kind("Fixed64Kind")).Add(value("ValueOfUint64", jen.Lit(42)))
+				g.Case(kind("FloatKind")).Add(value("ValueOfFloat32", jen.Lit(4.2)))
+				g.Case(kind("DoubleKind")).Add(value("ValueOfFloat64", jen.Lit(4.2)))
+				g.Case(kind("StringKind")).Add(value("ValueOfString", jen.String().Call(jen.Id("fd").Dot("FullName").Call())))
+				g.Case(kind("BytesKind")).Add(value("ValueOfBytes", jen.Index().Byte().Call(jen.Id("fd").Dot("FullName").Call())))
+			}))
+
+			g.Add(jen.If(jen.Id("fd").Dot("IsList").Call()).Block(
+				jen.Id("m").Dot("Mutable").Call(jen.Id("fd")).Dot("List").Call().Dot("Append").Call(jen.Id("v")),
+			).Else().Block(
+				jen.Id("m").Dot("Set").Call(jen.Id("fd"), jen.Id("v")),
+			))
+		}))
+	})
+}
+
+// ServiceConverterTest generates the test doing a round trip of all the
+// messages of a service through its data converter
+func ServiceConverterTest(gf *protogen.GeneratedFile, service *protogen.Service, config *Config) error {
+	_, encoding, err := getProtoConverterEncodings(config)
+	if err != nil {
+		return err
+	}
+
+	converterTest := jen.Null()
+
+	converterTest.Comment(fmt.Sprintf("Test%sDataConverter checks the messages of the %s service survive a round trip through its data converter", service.GoName, service.GoName)).Line().
+		Func().Id(fmt.Sprintf("Test%sDataConverter", service.GoName)).Params(jen.Id("t").Op("*").Id(getTestingObject(gf, "T"))).BlockFunc(func(g *jen.Group) {
+		g.Add(jen.Id("dataConverter").Op(":=").Id(getDataConverterName(service)).Call())
+		g.Line()
+
+		g.Comment("fill sets all the fields of a message so the round trip is not done on an empty one")
+		g.Add(fillMessage(gf))
+		g.Line()
+
+		g.Add(jen.Id("roundTrip").Op(":=").Func().Params(
+			jen.Id("t").Op("*").Id(getTestingObject(gf, "T")),
+			jen.Id("in").Id(getProtoObject(gf, "Message")),
+			jen.Id("out").Interface(),
+		).BlockFunc(func(g *jen.Group) {
+			g.Add(jen.List(jen.Id("payload"), jen.Err()).Op(":=").Id("dataConverter").Dot("ToPayload").Call(jen.Id("in")))
+			g.Add(jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Id("t").Dot("Fatalf").Call(jen.Lit("could not encode the message: %s"), jen.Err()),
+			))
+
+			g.Add(jen.If(
+				jen.Id("enc").Op(":=").String().Call(jen.Id("payload").Dot("GetMetadata").Call().Index(jen.Id(getTemporalConverterObject(gf, "MetadataEncoding")))),
+				jen.Id("enc").Op("!=").Id(getTemporalConverterObject(gf, encoding)),
+			).Block(
+				jen.Id("t").Dot("Errorf").Call(jen.Lit("message encoded as %s instead of %s"), jen.Id("enc"), jen.Id(getTemporalConverterObject(gf, encoding))),
+			))
+
+			g.Add(jen.If(jen.Err().Op(":=").Id("dataConverter").Dot("FromPayload").Call(jen.Id("payload"), jen.Id("out")), jen.Err().Op("!=").Nil()).Block(
+				jen.Id("t").Dot("Fatalf").Call(jen.Lit("could not decode the message: %s"), jen.Err()),
+			))
+		}))
+
+		for _, message := range getServiceMessages(service) {
+			g.Line()
+			g.Add(jen.Id("t").Dot("Run").Call(jen.Lit(string(message.Desc.FullName())), jen.Func().Params(jen.Id("t").Op("*").Id(getTestingObject(gf, "T"))).BlockFunc(func(g *jen.Group) {
+				g.Add(jen.Id("in").Op(":=").Op("&").Id(gf.QualifiedGoIdent(message.GoIdent)).Values())
+				g.Add(jen.Id("fill").Call(jen.Id("in").Dot("ProtoReflect").Call(), jen.Lit(0)))
+				g.Add(jen.Var().Id("out").Op("*").Id(gf.QualifiedGoIdent(message.GoIdent)))
+				g.Add(jen.Id("roundTrip").Call(jen.Id("t"), jen.Id("in"), jen.Op("&").Id("out")))
+				g.Add(jen.If(jen.Op("!").Id(getProtoObject(gf, "Equal")).Call(jen.Id("in"), jen.Id("out"))).Block(
+					jen.Id("t").Dot("Errorf").Call(jen.Lit("the message changed during the round trip, got %v instead of %v"), jen.Id("out"), jen.Id("in")),
+				))
+			})))
+		}
+	}).Line()
+
+	buf := bytes.NewBufferString("")
+	if err := converterTest.Render(buf); err != nil {
+		return err
+	}
+
+	gf.P(buf.String())
+
+	return nil
+}
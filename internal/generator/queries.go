@@ -10,7 +10,7 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-func ServiceQueries(gf *protogen.GeneratedFile, service *protogen.Service) error {
+func ServiceQueries(gf *protogen.GeneratedFile, service *protogen.Service, config *Config) error {
 	clientName := getClientName(service)
 
 	queries := jen.Null()
@@ -32,45 +32,20 @@ func ServiceQueries(gf *protogen.GeneratedFile, service *protogen.Service) error
 
 		queryOpts, _ := proto.GetExtension(method.Desc.Options(), temporalv1.E_Query).(*temporalv1.QueryOptions)
 
-		queries.Comment(fmt.Sprintf("Query%s sends the %s query to a workflow", method.GoName, method.GoName)).Line().
-			Func().Parens(jen.Id("c").Op("*").Id(clientName)).Id(fmt.Sprintf("Query%s", method.GoName)).ParamsFunc(func(g *jen.Group) {
-			g.Add(jen.Id("ctx").Id(getContext(gf)))
-			g.Add(jen.Id("workflowID").String())
-			g.Add(jen.Id("runID").String())
-			g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent)))
-		}).ParamsFunc(func(g *jen.Group) {
-			g.Add(jen.Op("*").Id(gf.QualifiedGoIdent(method.Output.GoIdent)))
-			g.Add(jen.Error())
-		}).BlockFunc(func(g *jen.Group) {
-			queryName := methName
-			if queryOpts != nil && queryOpts.Name != "" {
-				queryName = queryOpts.Name
-			}
-
-			g.Add(jen.Id("future").Op(",").Err().Op(":=").Id("c").Dot("client").Dot("QueryWorkflow").CallFunc(func(g *jen.Group) {
-				g.Add(jen.Id("ctx"))
-				g.Add(jen.Id("workflowID"))
-				g.Add(jen.Id("runID"))
-				g.Add(jen.Lit(queryName))
-				g.Add(jen.Id("req"))
-			}))
-
-			g.Add(IfErrNilDouble)
-
-			g.Add(jen.Var().Id("resp").Op("*").Id(gf.QualifiedGoIdent(method.Output.GoIdent)))
-
-			g.Add(jen.Id("err").Op("=").Id("future").Dot("Get").CallFunc(func(g *jen.Group) {
-				g.Add(jen.Op("&").Id("resp"))
-			}))
+		hook, err := getClientHook(gf, service, config, fmt.Sprintf("Query%s", method.GoName))
+		if err != nil {
+			return err
+		}
 
-			g.Add(IfErrNilDouble)
+		queries.Comment(fmt.Sprintf("Query%s sends the %s query to a workflow", method.GoName, method.GoName)).Line().
+			Add(hook.method(clientName)).Line().Line()
 
-			g.Add(jen.ReturnFunc(func(g *jen.Group) {
-				g.Add(jen.Id("resp"))
-				g.Add(jen.Nil())
-			}))
+		impl, err := queryImpl(gf, service, hook)
+		if err != nil {
+			return err
+		}
 
-		}).Line()
+		queries.Add(impl)
 
 		queries.Comment(fmt.Sprintf("HandleQuery%s sets up the %s query and responds accordingly, returns an error if it failed", method.GoName, method.GoName)).Line().
 			Func().Id(fmt.Sprintf("HandleQuery%s", method.GoName)).ParamsFunc(func(g *jen.Group) {
@@ -106,3 +81,35 @@ func ServiceQueries(gf *protogen.GeneratedFile, service *protogen.Service) error
 
 	return nil
 }
+
+// queryImpl returns the unexported method of the client sending a query once
+// the client interceptors ran
+func queryImpl(gf *protogen.GeneratedFile, service *protogen.Service, hook *clientHook) (*jen.Statement, error) {
+	queryName, err := getMethodRegisteredName(hook.rpc)
+	if err != nil {
+		return nil, err
+	}
+
+	params, _, results := hook.signature()
+
+	return jen.Comment(fmt.Sprintf("%s sends the query once the client interceptors ran", hook.getImplName())).Line().
+		Func().Parens(jen.Id("c").Op("*").Id(getClientName(service))).Id(hook.getImplName()).Params(params...).Params(results...).BlockFunc(func(g *jen.Group) {
+		g.Add(jen.List(jen.Id("future"), jen.Err()).Op(":=").Id("c").Dot("client").Dot("QueryWorkflow").Call(
+			jen.Id("ctx"),
+			jen.Id("workflowID"),
+			jen.Id("runID"),
+			jen.Lit(queryName),
+			jen.Id("req"),
+		))
+
+		g.Add(IfErrNilDouble)
+
+		g.Add(jen.Var().Id("resp").Op("*").Id(gf.QualifiedGoIdent(hook.rpc.Output.GoIdent)))
+
+		g.Add(jen.Err().Op("=").Id("future").Dot("Get").Call(jen.Op("&").Id("resp")))
+
+		g.Add(IfErrNilDouble)
+
+		g.Add(jen.Return(jen.Id("resp"), jen.Nil()))
+	}).Line(), nil
+}
@@ -0,0 +1,99 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func getQueryHandlersName(service *protogen.Service, method *protogen.Method) string {
+	return fmt.Sprintf("%sQueryHandlers", getWorkflowObjectName(service, method))
+}
+
+func ServiceQueryHandlers(gf *protogen.GeneratedFile, service *protogen.Service, config *Config) error {
+	handlers := jen.Null()
+	found := false
+
+	for _, method := range service.Methods {
+		t, err := getMethodType(method)
+		if err != nil {
+			return err
+		}
+
+		if t != MethodTypeWorkflow {
+			continue
+		}
+
+		workflowOptions := getWorkflowOptions(method)
+		if workflowOptions == nil {
+			workflowOptions = getDefaultWorkflowOptions(service)
+		}
+
+		if len(workflowOptions.GetQueries()) == 0 {
+			continue
+		}
+
+		queries := make([]*protogen.Method, 0, len(workflowOptions.GetQueries()))
+		queryNames := make(map[*protogen.Method]string)
+		for _, query := range workflowOptions.GetQueries() {
+			meth, err := config.registry.findMethod(service, query, MethodTypeQuery)
+			if err != nil {
+				return fmt.Errorf("invalid workflow %s: %w", method.GoName, err)
+			}
+
+			queryNames[meth], err = getMethodRegisteredName(meth)
+			if err != nil {
+				return err
+			}
+			queries = append(queries, meth)
+		}
+
+		found = true
+		handlersName := getQueryHandlersName(service, method)
+
+		handlers.Comment(fmt.Sprintf("%s handles all the queries of the %s workflow", handlersName, method.GoName)).Line().
+			Type().Id(handlersName).InterfaceFunc(func(g *jen.Group) {
+			for _, query := range queries {
+				g.Add(jen.Comment(fmt.Sprintf("Query%s responds to the %s query", query.GoName, query.GoName)))
+				g.Add(jen.Id("Query" + query.GoName).ParamsFunc(func(g *jen.Group) {
+					g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(query.Input.GoIdent)))
+				}).ParamsFunc(func(g *jen.Group) {
+					g.Add(jen.Op("*").Id(gf.QualifiedGoIdent(query.Output.GoIdent)))
+					g.Add(jen.Error())
+				}))
+			}
+		}).Line()
+
+		handlers.Comment(fmt.Sprintf("Register%s sets up all the queries of the %s workflow, returns an error if one of them failed", handlersName, method.GoName)).Line().
+			Func().Id("Register" + handlersName).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Id("ctx").Id(getTemporalWorkflowObject(gf, "Context")))
+			g.Add(jen.Id("impl").Id(handlersName))
+		}).Error().BlockFunc(func(g *jen.Group) {
+			for _, query := range queries {
+				g.Add(jen.If(
+					jen.Err().Op(":=").Id(getTemporalWorkflowObject(gf, "SetQueryHandler")).Call(jen.Id("ctx"), jen.Lit(queryNames[query]), jen.Id("impl").Dot("Query"+query.GoName)),
+					jen.Err().Op("!=").Nil(),
+				).Block(
+					jen.Return(jen.Id(getFmtObject(gf, "Errorf")).Call(jen.Lit(fmt.Sprintf("could not set up the %s query: %%w", query.GoName)), jen.Err())),
+				))
+			}
+
+			g.Add(jen.Return(jen.Nil()))
+		}).Line()
+	}
+
+	if !found {
+		return nil
+	}
+
+	buf := bytes.NewBufferString("")
+	if err := handlers.Render(buf); err != nil {
+		return err
+	}
+
+	gf.P(buf.String())
+
+	return nil
+}
@@ -42,46 +42,114 @@ func addComments(f *protogen.GeneratedFile, comments protogen.CommentSet) {
 }
 
 func addWorkflowOptions(f *protogen.GeneratedFile, svc *protogen.Service, opts *temporalv1.WorkflowOptions) error {
-	if opts.WorkflowExecutionTimeout != nil {
-		f.P(fmt.Sprintf("| Workflow execution timeout | %v |", time.Second*time.Duration(opts.GetWorkflowExecutionTimeout())))
+	opts = normalizeWorkflowOptions(opts)
+
+	if opts.WorkflowExecutionTimeoutDuration != nil {
+		f.P(fmt.Sprintf("| Workflow execution timeout | %v |", opts.GetWorkflowExecutionTimeoutDuration().AsDuration()))
+	}
+
+	if opts.WorkflowRunTimeoutDuration != nil {
+		f.P(fmt.Sprintf("| Workflow run timeout | %v |", opts.GetWorkflowRunTimeoutDuration().AsDuration()))
+	}
+
+	if opts.WorkflowTaskTimeoutDuration != nil {
+		f.P(fmt.Sprintf("| Workflow task timeout | %v |", opts.GetWorkflowTaskTimeoutDuration().AsDuration()))
+	}
+
+	if opts.IdTemplate != "" {
+		f.P(fmt.Sprintf("| Workflow ID template | `%s` |", opts.GetIdTemplate()))
+	}
+
+	if opts.IdReusePolicy != temporalv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_UNSPECIFIED {
+		f.P(fmt.Sprintf("| Workflow ID reuse policy | %s |", opts.GetIdReusePolicy()))
+	}
+
+	if opts.IdConflictPolicy != temporalv1.WorkflowIdConflictPolicy_WORKFLOW_ID_CONFLICT_POLICY_UNSPECIFIED {
+		f.P(fmt.Sprintf("| Workflow ID conflict policy | %s |", opts.GetIdConflictPolicy()))
+	}
+
+	if opts.ErrorWhenAlreadyStarted != nil {
+		f.P(fmt.Sprintf("| Error when already started | %v |", opts.GetErrorWhenAlreadyStarted()))
+	}
+
+	if len(opts.Errors) != 0 {
+		f.P(fmt.Sprintf("| Errors | `%s` |", strings.Join(opts.GetErrors(), "`, `")))
+	}
+
+	if opts.ParentClosePolicy != temporalv1.ParentClosePolicy_PARENT_CLOSE_POLICY_UNSPECIFIED {
+		f.P(fmt.Sprintf("| Parent close policy | %s |", opts.GetParentClosePolicy()))
+	}
+
+	if opts.WaitForCancellation != nil {
+		f.P(fmt.Sprintf("| Wait for cancellation | %v |", opts.GetWaitForCancellation()))
 	}
 
-	if opts.WorkflowRunTimeout != nil {
-		f.P(fmt.Sprintf("| Workflow run timeout | %v |", time.Second*time.Duration(opts.GetWorkflowRunTimeout())))
+	if opts.ContinueAsNewHistoryLength != nil {
+		f.P(fmt.Sprintf("| Continue as new history length | %d events |", opts.GetContinueAsNewHistoryLength()))
 	}
 
-	if opts.WorkflowTaskTimeout != nil {
-		f.P(fmt.Sprintf("| Workflow task timeout | %v |", time.Second*time.Duration(opts.GetWorkflowTaskTimeout())))
+	if opts.ContinueAsNewHistorySize != nil {
+		f.P(fmt.Sprintf("| Continue as new history size | %d bytes |", opts.GetContinueAsNewHistorySize()))
 	}
 
 	if opts.RetryPolicy != nil {
 		addRetryPolicy(f, opts.RetryPolicy)
 	}
 
+	if opts.Schedule != nil {
+		addSchedule(f, opts.Schedule)
+	}
+
 	return nil
 }
 
 func addActivityOptions(f *protogen.GeneratedFile, svc *protogen.Service, opts *temporalv1.ActivityOptions) error {
-	if opts.ScheduleToCloseTimeout != nil {
-		f.P(fmt.Sprintf("| Schedule to close timeout | %v |", time.Second*time.Duration(opts.GetScheduleToCloseTimeout())))
+	opts = normalizeActivityOptions(opts)
+
+	if opts.ScheduleToCloseTimeoutDuration != nil {
+		f.P(fmt.Sprintf("| Schedule to close timeout | %v |", opts.GetScheduleToCloseTimeoutDuration().AsDuration()))
+	}
+
+	if opts.ScheduleToStartTimeoutDuration != nil {
+		f.P(fmt.Sprintf("| Schedule to start timeout | %v |", opts.GetScheduleToStartTimeoutDuration().AsDuration()))
+	}
+
+	if opts.StartToCloseTimeoutDuration != nil {
+		f.P(fmt.Sprintf("| Start to close timeout | %v |", opts.GetStartToCloseTimeoutDuration().AsDuration()))
+	}
+
+	if opts.HeartbeatTimeoutDuration != nil {
+		f.P(fmt.Sprintf("| Heartbeat timeout | %v |", opts.GetHeartbeatTimeoutDuration().AsDuration()))
+	}
+
+	if opts.HeartbeatDetails != "" {
+		f.P(fmt.Sprintf("| Heartbeat details | `%s` |", opts.GetHeartbeatDetails()))
+	}
+
+	if len(opts.Errors) != 0 {
+		f.P(fmt.Sprintf("| Errors | `%s` |", strings.Join(opts.GetErrors(), "`, `")))
 	}
 
-	if opts.ScheduleToStartTimeout != nil {
-		f.P(fmt.Sprintf("| Schedule to start timeout | %v |", time.Second*time.Duration(opts.GetScheduleToStartTimeout())))
+	if opts.Local {
+		f.P("| Local | true |")
 	}
 
-	if opts.StartToCloseTimeout != nil {
-		f.P(fmt.Sprintf("| Start to close timeout | %v |", time.Second*time.Duration(opts.GetStartToCloseTimeout())))
+	if opts.LocalScheduleToCloseTimeoutDuration != nil {
+		f.P(fmt.Sprintf("| Local schedule to close timeout | %v |", opts.GetLocalScheduleToCloseTimeoutDuration().AsDuration()))
 	}
 
-	if opts.HeartbeatTimeout != nil {
-		f.P(fmt.Sprintf("| Heartbeat timeout | %v |", time.Second*time.Duration(opts.GetHeartbeatTimeout())))
+	if opts.LocalStartToCloseTimeoutDuration != nil {
+		f.P(fmt.Sprintf("| Local start to close timeout | %v |", opts.GetLocalStartToCloseTimeoutDuration().AsDuration()))
 	}
 
 	if opts.RetryPolicy != nil {
 		addRetryPolicy(f, opts.RetryPolicy)
 	}
 
+	if opts.LocalRetryPolicy != nil {
+		addNamedRetryPolicy(f, "Local retry policy", opts.LocalRetryPolicy)
+	}
+
 	return nil
 }
 
@@ -99,6 +167,13 @@ func addQueryOptions(f *protogen.GeneratedFile, svc *protogen.Service, opts *tem
 	return nil
 }
 
+func addUpdateOptions(f *protogen.GeneratedFile, svc *protogen.Service, opts *temporalv1.UpdateOptions) error {
+	if opts.Name != "" {
+		f.P(fmt.Sprintf("Update name: `%s`", opts.Name))
+	}
+	return nil
+}
+
 func addMethOptions(f *protogen.GeneratedFile, svc *protogen.Service, meth *protogen.Method) error {
 	t, err := getMethodType(meth)
 	if err != nil {
@@ -149,24 +224,59 @@ func addMethOptions(f *protogen.GeneratedFile, svc *protogen.Service, meth *prot
 		if err != nil {
 			return err
 		}
+
+	case MethodTypeUpdate:
+		opts, _ := proto.GetExtension(meth.Desc.Options(), temporalv1.E_Update).(*temporalv1.UpdateOptions)
+		if opts == nil {
+			return nil
+		}
+
+		err = addUpdateOptions(f, svc, opts)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
 func addRetryPolicy(f *protogen.GeneratedFile, rp *temporalv1.RetryPolicy) {
+	addNamedRetryPolicy(f, "Retry policy", rp)
+}
+
+func addNamedRetryPolicy(f *protogen.GeneratedFile, title string, rp *temporalv1.RetryPolicy) {
 	if rp == nil {
 		return
 	}
+	rp = normalizeRetryPolicy(rp)
 
-	f.P("\nRetry policy:\n")
+	f.P(fmt.Sprintf("\n%s:\n", title))
 	f.P("| Option | Value |")
 	f.P("| --- | --- |")
-	f.P(fmt.Sprintf("| Initial interval | %v |", time.Second*time.Duration(rp.GetInitialInterval())))
+	f.P(fmt.Sprintf("| Initial interval | %v |", rp.GetInitialIntervalDuration().AsDuration()))
 	f.P(fmt.Sprintf("| Backoff coefficient | %f |", rp.GetBackoffCoefficient()))
 	f.P(fmt.Sprintf("| Maximum attempts | %d |", rp.GetMaximumAttempts()))
-	f.P(fmt.Sprintf("| Maximum interval | %v |", time.Second*time.Duration(rp.GetMaximumInterval())))
+	f.P(fmt.Sprintf("| Maximum interval | %v |", rp.GetMaximumIntervalDuration().AsDuration()))
 	f.P(fmt.Sprintf("| Non retryable error types | %v |", rp.GetNonRetryableErrorTypes()))
+	if rp.GetNonRetryableErrorEnum() != "" {
+		f.P(fmt.Sprintf("| Non retryable error enum | `%s` |", rp.GetNonRetryableErrorEnum()))
+	}
+	f.P("")
+}
+
+func addSchedule(f *protogen.GeneratedFile, schedule *temporalv1.ScheduleOptions) {
+	f.P("\nSchedule:\n")
+	f.P("| Option | Value |")
+	f.P("| --- | --- |")
+	for _, cron := range schedule.GetCronExpressions() {
+		f.P(fmt.Sprintf("| Cron expression | `%s` |", cron))
+	}
+	for _, interval := range schedule.GetIntervals() {
+		f.P(fmt.Sprintf("| Interval | every %v, offset %v |", time.Second*time.Duration(interval.GetEvery()), time.Second*time.Duration(interval.GetOffset())))
+	}
+	f.P(fmt.Sprintf("| Jitter | %v |", time.Second*time.Duration(schedule.GetJitter())))
+	f.P(fmt.Sprintf("| Overlap policy | %s |", schedule.GetOverlapPolicy()))
+	f.P(fmt.Sprintf("| Pause on failure | %v |", schedule.GetPauseOnFailure()))
 	f.P("")
 }
 
@@ -215,6 +325,13 @@ func addMethodDocs(f *protogen.GeneratedFile, svc *protogen.Service, meth *proto
 			}
 		}
 
+		if len(opts.Updates) != 0 {
+			f.P("\nUpdates:")
+			for _, u := range opts.Updates {
+				f.P(fmt.Sprintf(" * [%s.%s](#%s)", svc.Desc.FullName(), u, makeAnchor("method", string(svc.Desc.FullName())+"."+u)))
+			}
+		}
+
 		f.P("")
 	}
 
@@ -230,6 +347,7 @@ func ReadmeService(f *protogen.GeneratedFile, service *protogen.Service, cfg *Co
 	activities := make([]*protogen.Method, 0)
 	signals := make([]*protogen.Method, 0)
 	queries := make([]*protogen.Method, 0)
+	updates := make([]*protogen.Method, 0)
 
 	for _, meth := range service.Methods {
 		t, err := getMethodType(meth)
@@ -246,6 +364,8 @@ func ReadmeService(f *protogen.GeneratedFile, service *protogen.Service, cfg *Co
 			signals = append(signals, meth)
 		case MethodTypeQuery:
 			queries = append(queries, meth)
+		case MethodTypeUpdate:
+			updates = append(updates, meth)
 		}
 	}
 
@@ -275,6 +395,12 @@ func ReadmeService(f *protogen.GeneratedFile, service *protogen.Service, cfg *Co
 			f.P(fmt.Sprintf("   * [%s](#%s)", meth.Desc.FullName(), makeAnchor("method", string(meth.Desc.FullName()))))
 		}
 	}
+	if len(updates) != 0 {
+		f.P(" * Updates")
+		for _, meth := range updates {
+			f.P(fmt.Sprintf("   * [%s](#%s)", meth.Desc.FullName(), makeAnchor("method", string(meth.Desc.FullName()))))
+		}
+	}
 
 	f.P()
 
@@ -342,6 +468,14 @@ func ReadmeService(f *protogen.GeneratedFile, service *protogen.Service, cfg *Co
 		}
 	}
 
+	f.P("### Updates")
+	for _, meth := range updates {
+		err := addMethodDocs(f, service, meth)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -379,14 +513,16 @@ func ReadmeMessage(f *protogen.GeneratedFile, message *protogen.Message, cfg *Co
 	for _, field := range message.Fields {
 		deprecated := "✅"
 		fieldOptions, _ := field.Desc.Options().(*descriptorpb.FieldOptions)
-		if fieldOptions != nil {
-			if *fieldOptions.Deprecated {
-				deprecated = "🗿"
-			}
+		if fieldOptions.GetDeprecated() {
+			deprecated = "🗿"
+		}
+		name := field.GoName
+		if isSensitiveField(field) {
+			name += " 🔒"
 		}
 		f.P(fmt.Sprintf(
 			"| %s | %s | %s | %v | <pre>%s</pre> |",
-			field.GoName,
+			name,
 			field.Desc.Kind().String(),
 			cardinalityToString(field.Desc.Cardinality()),
 			deprecated,
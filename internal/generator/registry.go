@@ -0,0 +1,175 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// registry indexes the messages and methods of all the files of a request, so
+// the options can reference messages that are not used by the RPCs themselves
+// and methods of other services
+type registry struct {
+	messages map[protoreflect.FullName]*protogen.Message
+	enums    map[protoreflect.FullName]*protogen.Enum
+	methods  map[protoreflect.FullName]*protogen.Method
+	files    []*protogen.File
+}
+
+func newRegistry(plugin *protogen.Plugin) *registry {
+	reg := &registry{
+		messages: make(map[protoreflect.FullName]*protogen.Message),
+		enums:    make(map[protoreflect.FullName]*protogen.Enum),
+		methods:  make(map[protoreflect.FullName]*protogen.Method),
+		files:    plugin.Files,
+	}
+
+	addEnums := func(enums []*protogen.Enum) {
+		for _, enum := range enums {
+			reg.enums[enum.Desc.FullName()] = enum
+		}
+	}
+
+	var addMessages func(messages []*protogen.Message)
+	addMessages = func(messages []*protogen.Message) {
+		for _, message := range messages {
+			reg.messages[message.Desc.FullName()] = message
+			addEnums(message.Enums)
+			addMessages(message.Messages)
+		}
+	}
+
+	for _, file := range plugin.Files {
+		addEnums(file.Enums)
+		addMessages(file.Messages)
+		for _, service := range file.Services {
+			for _, method := range service.Methods {
+				reg.methods[method.Desc.FullName()] = method
+			}
+		}
+	}
+
+	return reg
+}
+
+// withRegistry returns a copy of the config holding the registry of the plugin
+func withRegistry(plugin *protogen.Plugin, config *Config) *Config {
+	cfg := *config
+	cfg.registry = newRegistry(plugin)
+	return &cfg
+}
+
+// declaringFile returns the file of the Go package of `file` generating the
+// declarations shared by the files of the package for a message or an enum:
+// the file defining it when it has temporal services, the first file using
+// it otherwise. This way two files of a package never declare them both
+func (r *registry) declaringFile(file *protogen.File, desc protoreflect.Descriptor, uses func(*protogen.File) (bool, error)) (*protogen.File, error) {
+	candidates := make([]*protogen.File, 0)
+	for _, f := range r.files {
+		if !f.Generate || !isTemporalFile(f) || f.GoImportPath != file.GoImportPath {
+			continue
+		}
+
+		if f.Desc == desc.ParentFile() {
+			return f, nil
+		}
+		candidates = append(candidates, f)
+	}
+
+	for _, f := range candidates {
+		used, err := uses(f)
+		if err != nil {
+			return nil, err
+		}
+		if used {
+			return f, nil
+		}
+	}
+
+	return file, nil
+}
+
+// findMessage resolves a message referenced by an option of the service, the
+// name being either fully qualified or relative to the package of the service
+func (r *registry) findMessage(service *protogen.Service, name string) (*protogen.Message, error) {
+	name = strings.TrimPrefix(name, ".")
+
+	if message, ok := r.messages[protoreflect.FullName(name)]; ok {
+		return message, nil
+	}
+
+	pkg := service.Desc.ParentFile().Package()
+	if message, ok := r.messages[protoreflect.FullName(string(pkg)+"."+name)]; ok {
+		return message, nil
+	}
+
+	return nil, fmt.Errorf("no message %s found for service %s", name, service.Desc.FullName())
+}
+
+// findEnum resolves an enum referenced by an option of the service, the
+// name being either fully qualified or relative to the package of the service
+func (r *registry) findEnum(service *protogen.Service, name string) (*protogen.Enum, error) {
+	name = strings.TrimPrefix(name, ".")
+
+	if enum, ok := r.enums[protoreflect.FullName(name)]; ok {
+		return enum, nil
+	}
+
+	pkg := service.Desc.ParentFile().Package()
+	if enum, ok := r.enums[protoreflect.FullName(string(pkg)+"."+name)]; ok {
+		return enum, nil
+	}
+
+	return nil, fmt.Errorf("no enum %s found for service %s", name, service.Desc.FullName())
+}
+
+// findMethod resolves a signal, query or update referenced by a workflow of the
+// service. The name is either the one of a RPC of the same service or the fully
+// qualified name of a RPC of any service, like `common.v1.Control.Pause`
+func (r *registry) findMethod(service *protogen.Service, name string, t MethodType) (*protogen.Method, error) {
+	var method *protogen.Method
+	if strings.Contains(name, ".") {
+		method = r.methods[protoreflect.FullName(strings.TrimPrefix(name, "."))]
+	} else {
+		for _, m := range service.Methods {
+			if m.GoName == name {
+				method = m
+				break
+			}
+		}
+	}
+
+	if method != nil {
+		methodType, err := getMethodType(method)
+		if err != nil {
+			return nil, err
+		}
+		if methodType == t {
+			return method, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no %s %s defined for service %s", strings.ToLower(string(t)), name, service.GoName)
+}
+
+// checkMethodNames makes sure the methods referenced by a workflow do not end up
+// generating the same Go method, which happens when two services define a RPC
+// with the same name
+func (r *registry) checkMethodNames(service *protogen.Service, workflow *protogen.Method, names []string, t MethodType) error {
+	seen := make(map[string]*protogen.Method)
+	for _, name := range names {
+		method, err := r.findMethod(service, name, t)
+		if err != nil {
+			return fmt.Errorf("invalid workflow %s: %w", workflow.GoName, err)
+		}
+
+		if other, ok := seen[method.GoName]; ok && other != method {
+			return fmt.Errorf("invalid workflow %s: %s and %s would generate the same methods", workflow.GoName, other.Desc.FullName(), method.Desc.FullName())
+		}
+		seen[method.GoName] = method
+	}
+
+	return nil
+}
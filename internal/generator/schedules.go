@@ -0,0 +1,302 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func getScheduleName(service *protogen.Service, method *protogen.Method) string {
+	return fmt.Sprintf("%s%sSchedule", service.GoName, method.GoName)
+}
+
+func ServiceSchedules(gf *protogen.GeneratedFile, service *protogen.Service, config *Config) error {
+	clientName := getClientName(service)
+
+	schedules := jen.Null()
+
+	for _, method := range service.Methods {
+		t, err := getMethodType(method)
+		if err != nil {
+			return err
+		}
+
+		if t != MethodTypeWorkflow {
+			continue
+		}
+
+		workflowOptions := getMergedWorkflowOptions(service, method)
+		workflowOptions.RetryPolicy, err = config.registry.expandRetryPolicy(service, workflowOptions.RetryPolicy)
+		if err != nil {
+			return fmt.Errorf("invalid retry policy for %s: %w", method.Desc.FullName(), err)
+		}
+		schedule := workflowOptions.GetSchedule()
+		if schedule == nil {
+			continue
+		}
+
+		if len(schedule.CronExpressions) == 0 && len(schedule.Intervals) == 0 {
+			return fmt.Errorf("the schedule of workflow %s needs at least a cron expression or an interval", method.Desc.FullName())
+		}
+
+		for _, interval := range schedule.Intervals {
+			if interval.Every <= 0 {
+				return fmt.Errorf("the schedule of workflow %s has an interval with a period that is not positive", method.Desc.FullName())
+			}
+			if interval.Offset < 0 {
+				return fmt.Errorf("the schedule of workflow %s has an interval with a negative offset", method.Desc.FullName())
+			}
+			if interval.Offset >= interval.Every {
+				return fmt.Errorf("the schedule of workflow %s has an interval with an offset of %ds, it must be shorter than its %ds period", method.Desc.FullName(), interval.Offset, interval.Every)
+			}
+		}
+
+		if schedule.GetJitter() < 0 {
+			return fmt.Errorf("the schedule of workflow %s has a negative jitter", method.Desc.FullName())
+		}
+
+		workflowName, err := getMethodRegisteredName(method)
+		if err != nil {
+			return err
+		}
+
+		scheduleName := getScheduleName(service, method)
+
+		/*
+			Schedule handle struct
+
+			type SomeServiceSomeWorkflowSchedule struct {
+				handle client.ScheduleHandle
+			}
+		*/
+		schedules.Comment(fmt.Sprintf("%s is a typed handle to a schedule of the %s workflow", scheduleName, method.GoName)).Line().
+			Type().Id(scheduleName).StructFunc(func(g *jen.Group) {
+			g.Add(jen.Id("handle").Id(getTemporalClientObject(gf, "ScheduleHandle")))
+		}).Line()
+
+		schedules.Comment("GetID returns the ID of the schedule").Line().
+			Func().Parens(jen.Id("s").Op("*").Id(scheduleName)).Id("GetID").Parens(jen.Null()).String().
+			BlockFunc(func(g *jen.Group) {
+				g.Add(jen.Return(jen.Id("s").Dot("handle").Dot("GetID").Parens(jen.Null())))
+			}).Line()
+
+		schedules.Comment("Handle returns the underlying client.ScheduleHandle").Line().
+			Func().Parens(jen.Id("s").Op("*").Id(scheduleName)).Id("Handle").Parens(jen.Null()).Id(getTemporalClientObject(gf, "ScheduleHandle")).
+			BlockFunc(func(g *jen.Group) {
+				g.Add(jen.Return(jen.Id("s").Dot("handle")))
+			}).Line()
+
+		schedules.Comment("Describe fetches the current state of the schedule").Line().
+			Func().Parens(jen.Id("s").Op("*").Id(scheduleName)).Id("Describe").ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Id("ctx").Id(getContext(gf)))
+		}).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Op("*").Id(getTemporalClientObject(gf, "ScheduleDescription")))
+			g.Add(jen.Error())
+		}).BlockFunc(func(g *jen.Group) {
+			g.Add(jen.Return(jen.Id("s").Dot("handle").Dot("Describe").Call(jen.Id("ctx"))))
+		}).Line()
+
+		for _, action := range []struct {
+			name    string
+			options string
+			comment string
+		}{
+			{"Pause", "SchedulePauseOptions", "Pause pauses the schedule, no workflow will be started until it is unpaused"},
+			{"Unpause", "ScheduleUnpauseOptions", "Unpause resumes the schedule"},
+			{"Trigger", "ScheduleTriggerOptions", "Trigger starts the workflow immediately"},
+		} {
+			schedules.Comment(action.comment).Line().
+				Func().Parens(jen.Id("s").Op("*").Id(scheduleName)).Id(action.name).ParamsFunc(func(g *jen.Group) {
+				g.Add(jen.Id("ctx").Id(getContext(gf)))
+				g.Add(jen.Id("options").Op("...").Id(getTemporalClientObject(gf, action.options)))
+			}).Error().BlockFunc(func(g *jen.Group) {
+				g.Add(jen.Id("sOptions").Op(":=").Id(getTemporalClientObject(gf, action.options)).Block())
+				g.Add(jen.If(jen.Len(jen.Id("options")).Op(">").Lit(0).Block(
+					jen.Id("sOptions").Op("=").Id("options").Index(jen.Lit(0)),
+				)))
+
+				g.Add(jen.Return(jen.Id("s").Dot("handle").Dot(action.name).Call(jen.Id("ctx"), jen.Id("sOptions"))))
+			}).Line()
+		}
+
+		schedules.Comment(fmt.Sprintf("Update replaces the request the %s workflow is started with", method.GoName)).Line().
+			Func().Parens(jen.Id("s").Op("*").Id(scheduleName)).Id("Update").ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Id("ctx").Id(getContext(gf)))
+			g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent)))
+		}).Error().BlockFunc(func(g *jen.Group) {
+			g.Add(jen.Return(jen.Id("s").Dot("handle").Dot("Update").CallFunc(func(g *jen.Group) {
+				g.Add(jen.Id("ctx"))
+				g.Add(jen.Id(getTemporalClientObject(gf, "ScheduleUpdateOptions")).Values(jen.Dict{
+					jen.Id("DoUpdate"): jen.Func().Params(jen.Id("input").Id(getTemporalClientObject(gf, "ScheduleUpdateInput"))).ParamsFunc(func(g *jen.Group) {
+						g.Add(jen.Op("*").Id(getTemporalClientObject(gf, "ScheduleUpdate")))
+						g.Add(jen.Error())
+					}).BlockFunc(func(g *jen.Group) {
+						g.Add(jen.List(jen.Id("action"), jen.Id("ok")).Op(":=").Id("input").Dot("Description").Dot("Schedule").Dot("Action").Assert(jen.Op("*").Id(getTemporalClientObject(gf, "ScheduleWorkflowAction"))))
+						g.Add(jen.If(jen.Op("!").Id("ok")).Block(
+							jen.Return(jen.Nil(), jen.Id(getFmtObject(gf, "Errorf")).Call(jen.Lit("schedule %s does not start a workflow"), jen.Id("s").Dot("handle").Dot("GetID").Call())),
+						))
+
+						g.Add(jen.Id("action").Dot("Args").Op("=").Index().Interface().Values(jen.Id("req")))
+
+						g.Add(jen.Return(jen.Op("&").Id(getTemporalClientObject(gf, "ScheduleUpdate")).Values(jen.Dict{
+							jen.Id("Schedule"): jen.Op("&").Id("input").Dot("Description").Dot("Schedule"),
+						}), jen.Nil()))
+					}),
+				}))
+			})))
+		}).Line()
+
+		schedules.Comment("Delete deletes the schedule, running workflows are not affected").Line().
+			Func().Parens(jen.Id("s").Op("*").Id(scheduleName)).Id("Delete").ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Id("ctx").Id(getContext(gf)))
+		}).Error().BlockFunc(func(g *jen.Group) {
+			g.Add(jen.Return(jen.Id("s").Dot("handle").Dot("Delete").Call(jen.Id("ctx"))))
+		}).Line()
+
+		/*
+			Client side functions
+		*/
+		schedules.Comment(fmt.Sprintf("CreateSchedule%s creates a schedule starting the %s workflow with the given request", method.GoName, method.GoName)).Line().
+			Comment("The spec and policies defined in the proto are used unless they are set in `options`").Line().
+			Func().Parens(jen.Id("c").Op("*").Id(clientName)).Id(fmt.Sprintf("CreateSchedule%s", method.GoName)).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Id("ctx").Id(getContext(gf)))
+			g.Add(jen.Id("id").String())
+			g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent)))
+			g.Add(jen.Id("options").Op("...").Id(getTemporalClientObject(gf, "ScheduleOptions")))
+		}).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Op("*").Id(scheduleName))
+			g.Add(jen.Error())
+		}).BlockFunc(func(g *jen.Group) {
+			if validatesBeforeSending(method, config) {
+				g.Add(validateRequest(service, workflowName, "req", jen.Return(jen.Nil(), jen.Err())))
+			}
+
+			g.Add(jen.Id("sOptions").Op(":=").Id(getTemporalClientObject(gf, "ScheduleOptions")).Block())
+			g.Add(jen.If(jen.Len(jen.Id("options")).Op(">").Lit(0).Block(
+				jen.Id("sOptions").Op("=").Id("options").Index(jen.Lit(0)),
+			)))
+			g.Add(jen.Id("sOptions").Dot("ID").Op("=").Id("id"))
+
+			spec := jen.Id("sOptions").Dot("Spec")
+			g.Add(jen.If(
+				jen.Len(spec.Clone().Dot("CronExpressions")).Op("==").Lit(0).Op("&&").
+					Len(spec.Clone().Dot("Intervals")).Op("==").Lit(0).Op("&&").
+					Len(spec.Clone().Dot("Calendars")).Op("==").Lit(0),
+			).BlockFunc(func(g *jen.Group) {
+				if len(schedule.CronExpressions) != 0 {
+					g.Add(spec.Clone().Dot("CronExpressions").Op("=").Index().String().ValuesFunc(func(g *jen.Group) {
+						for _, cron := range schedule.CronExpressions {
+							g.Lit(cron)
+						}
+					}))
+				}
+				if len(schedule.Intervals) != 0 {
+					g.Add(spec.Clone().Dot("Intervals").Op("=").Index().Id(getTemporalClientObject(gf, "ScheduleIntervalSpec")).ValuesFunc(func(g *jen.Group) {
+						for _, interval := range schedule.Intervals {
+							g.Values(jen.DictFunc(func(d jen.Dict) {
+								d[jen.Id("Every")] = secondsDuration(gf, interval.Every)
+								if interval.Offset != 0 {
+									d[jen.Id("Offset")] = secondsDuration(gf, interval.Offset)
+								}
+							}))
+						}
+					}))
+				}
+			}))
+
+			if schedule.Jitter != nil {
+				g.Add(jen.If(spec.Clone().Dot("Jitter").Op("==").Lit(0)).Block(
+					spec.Clone().Dot("Jitter").Op("=").Add(secondsDuration(gf, *schedule.Jitter)),
+				))
+			}
+
+			if schedule.OverlapPolicy != 0 {
+				g.Add(jen.If(jen.Id("sOptions").Dot("Overlap").Op("==").Id(getTemporalEnumsObject(gf, "SCHEDULE_OVERLAP_POLICY_UNSPECIFIED"))).Block(
+					jen.Id("sOptions").Dot("Overlap").Op("=").Id(getTemporalEnumsObject(gf, schedule.OverlapPolicy.String())),
+				))
+			}
+
+			if schedule.PauseOnFailure {
+				g.Add(jen.Id("sOptions").Dot("PauseOnFailure").Op("=").True())
+			}
+
+			// the action is always the workflow, but it can be customised by passing a *client.ScheduleWorkflowAction
+			g.Add(jen.List(jen.Id("action"), jen.Id("ok")).Op(":=").Id("sOptions").Dot("Action").Assert(jen.Op("*").Id(getTemporalClientObject(gf, "ScheduleWorkflowAction"))))
+			g.Add(jen.If(jen.Op("!").Id("ok").Op("||").Id("action").Op("==").Nil()).Block(
+				jen.Id("action").Op("=").Op("&").Id(getTemporalClientObject(gf, "ScheduleWorkflowAction")).Block(),
+			))
+			g.Add(jen.Id("action").Dot("Workflow").Op("=").Lit(workflowName))
+			g.Add(jen.Id("action").Dot("Args").Op("=").Index().Interface().Values(jen.Id("req")))
+			g.Add(jen.If(jen.Id("action").Dot("TaskQueue").Op("==").Lit("")).Block(
+				jen.Id("action").Dot("TaskQueue").Op("=").Id("c").Dot("taskQueue"),
+			))
+
+			if config.GenWorkflowPrefix {
+				g.Add(jen.If(jen.Id("action").Dot("ID").Op("==").Lit("")).Block(
+					jen.Id("action").Dot("ID").Op("=").Id(getFmtObject(gf, "Sprintf")).Call(jen.Lit("%s/%s"), jen.Lit(workflowName), jen.Id("id")),
+				))
+			}
+
+			for _, timeout := range []struct {
+				field string
+				value *durationpb.Duration
+			}{
+				{"WorkflowExecutionTimeout", workflowOptions.WorkflowExecutionTimeoutDuration},
+				{"WorkflowRunTimeout", workflowOptions.WorkflowRunTimeoutDuration},
+				{"WorkflowTaskTimeout", workflowOptions.WorkflowTaskTimeoutDuration},
+			} {
+				if timeout.value == nil {
+					continue
+				}
+				g.Add(jen.If(jen.Id("action").Dot(timeout.field).Op("==").Lit(0)).Block(
+					jen.Id("action").Dot(timeout.field).Op("=").Add(duration(gf, timeout.value)),
+				))
+			}
+
+			if workflowOptions.RetryPolicy != nil {
+				g.Add(jen.If(jen.Id("action").Dot("RetryPolicy").Op("==").Nil()).Block(
+					jen.Id("action").Dot("RetryPolicy").Op("=").Add(retryPolicy(gf, workflowOptions.RetryPolicy)),
+				))
+			}
+
+			if hasSearchAttributes(method) {
+				g.Add(searchAttributesDefault(gf, service, method, jen.Id("action"), "req"))
+			}
+			if hasMemo(method) {
+				g.Add(memoDefault(service, method, jen.Id("action"), "req"))
+			}
+
+			g.Add(jen.Id("sOptions").Dot("Action").Op("=").Id("action"))
+
+			g.Add(jen.List(jen.Id("handle"), jen.Err()).Op(":=").Id("c").Dot("client").Dot("ScheduleClient").Call().Dot("Create").Call(jen.Id("ctx"), jen.Id("sOptions")))
+
+			g.Add(IfErrNilDouble)
+
+			g.Add(jen.Return(jen.Op("&").Id(scheduleName).Values(jen.Dict{
+				jen.Id("handle"): jen.Id("handle"),
+			}), jen.Nil()))
+		}).Line()
+
+		schedules.Comment(fmt.Sprintf("GetSchedule%s returns a handle to an existing schedule of the %s workflow", method.GoName, method.GoName)).Line().
+			Func().Parens(jen.Id("c").Op("*").Id(clientName)).Id(fmt.Sprintf("GetSchedule%s", method.GoName)).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Id("ctx").Id(getContext(gf)))
+			g.Add(jen.Id("id").String())
+		}).Op("*").Id(scheduleName).BlockFunc(func(g *jen.Group) {
+			g.Add(jen.Return(jen.Op("&").Id(scheduleName).Values(jen.Dict{
+				jen.Id("handle"): jen.Id("c").Dot("client").Dot("ScheduleClient").Call().Dot("GetHandle").Call(jen.Id("ctx"), jen.Id("id")),
+			})))
+		}).Line()
+	}
+
+	buf := bytes.NewBufferString("")
+	if err := schedules.Render(buf); err != nil {
+		return err
+	}
+
+	gf.P(buf.String())
+
+	return nil
+}
@@ -0,0 +1,260 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+	temporalv1 "github.com/thomas-maurice/protoc-gen-go-tmprl/gen/temporal/v1"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var searchAttributeNameRegexp = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// searchAttribute is a search attribute declared on a field of a workflow input
+type searchAttribute struct {
+	name      string
+	field     *protogen.Field
+	valueType temporalv1.IndexedValueType
+}
+
+// keyConstructors maps the search attribute types to the SDK function creating their key
+var keyConstructors = map[temporalv1.IndexedValueType]string{
+	temporalv1.IndexedValueType_INDEXED_VALUE_TYPE_TEXT:         "NewSearchAttributeKeyString",
+	temporalv1.IndexedValueType_INDEXED_VALUE_TYPE_KEYWORD:      "NewSearchAttributeKeyKeyword",
+	temporalv1.IndexedValueType_INDEXED_VALUE_TYPE_INT:          "NewSearchAttributeKeyInt64",
+	temporalv1.IndexedValueType_INDEXED_VALUE_TYPE_DOUBLE:       "NewSearchAttributeKeyFloat64",
+	temporalv1.IndexedValueType_INDEXED_VALUE_TYPE_BOOL:         "NewSearchAttributeKeyBool",
+	temporalv1.IndexedValueType_INDEXED_VALUE_TYPE_DATETIME:     "NewSearchAttributeKeyTime",
+	temporalv1.IndexedValueType_INDEXED_VALUE_TYPE_KEYWORD_LIST: "NewSearchAttributeKeyKeywordList",
+}
+
+func getSearchAttributeKeyName(service *protogen.Service, name string) string {
+	return fmt.Sprintf("%sSearchAttribute%s", service.GoName, strings.ToUpper(name[:1])+name[1:])
+}
+
+func getSearchAttributeUpdatesName(service *protogen.Service, method *protogen.Method) string {
+	return fmt.Sprintf("%s%sSearchAttributeUpdates", service.GoName, method.GoName)
+}
+
+// getSearchAttributes returns the search attributes declared on the fields of a message
+func getSearchAttributes(message *protogen.Message) ([]*searchAttribute, error) {
+	attributes := make([]*searchAttribute, 0)
+
+	for _, field := range message.Fields {
+		opts, _ := proto.GetExtension(field.Desc.Options(), temporalv1.E_SearchAttribute).(*temporalv1.SearchAttributeOptions)
+		if opts == nil {
+			continue
+		}
+
+		name := opts.GetName()
+		if name == "" {
+			name = field.GoName
+		}
+
+		if !searchAttributeNameRegexp.MatchString(name) {
+			return nil, fmt.Errorf("invalid search attribute name %q on field %s", name, field.Desc.FullName())
+		}
+
+		valueType, err := getSearchAttributeType(field, opts.GetType())
+		if err != nil {
+			return nil, err
+		}
+
+		attributes = append(attributes, &searchAttribute{
+			name:      name,
+			field:     field,
+			valueType: valueType,
+		})
+	}
+
+	return attributes, nil
+}
+
+// getSearchAttributeType infers the type of a search attribute from the kind of
+// its field and checks it is compatible with the one requested, if any
+func getSearchAttributeType(field *protogen.Field, requested temporalv1.IndexedValueType) (temporalv1.IndexedValueType, error) {
+	allowed := []temporalv1.IndexedValueType{}
+
+	switch {
+	case field.Desc.IsMap():
+	case field.Desc.IsList():
+		if field.Desc.Kind() == protoreflect.StringKind {
+			allowed = append(allowed, temporalv1.IndexedValueType_INDEXED_VALUE_TYPE_KEYWORD_LIST)
+		}
+	default:
+		switch field.Desc.Kind() {
+		case protoreflect.StringKind, protoreflect.EnumKind:
+			allowed = append(allowed, temporalv1.IndexedValueType_INDEXED_VALUE_TYPE_KEYWORD, temporalv1.IndexedValueType_INDEXED_VALUE_TYPE_TEXT)
+		case protoreflect.BoolKind:
+			allowed = append(allowed, temporalv1.IndexedValueType_INDEXED_VALUE_TYPE_BOOL)
+		case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+			protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+			protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+			protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+			allowed = append(allowed, temporalv1.IndexedValueType_INDEXED_VALUE_TYPE_INT)
+		case protoreflect.FloatKind, protoreflect.DoubleKind:
+			allowed = append(allowed, temporalv1.IndexedValueType_INDEXED_VALUE_TYPE_DOUBLE)
+		case protoreflect.MessageKind:
+			if field.Message.Desc.FullName() == "google.protobuf.Timestamp" {
+				allowed = append(allowed, temporalv1.IndexedValueType_INDEXED_VALUE_TYPE_DATETIME)
+			}
+		}
+	}
+
+	if len(allowed) == 0 {
+		return 0, fmt.Errorf("field %s cannot be used as a search attribute", field.Desc.FullName())
+	}
+
+	if requested == temporalv1.IndexedValueType_INDEXED_VALUE_TYPE_UNSPECIFIED {
+		return allowed[0], nil
+	}
+
+	for _, t := range allowed {
+		if t == requested {
+			return t, nil
+		}
+	}
+
+	return 0, fmt.Errorf("field %s cannot be used as a search attribute of type %s", field.Desc.FullName(), requested)
+}
+
+// searchAttributeValue returns the statement reading the value of the search attribute from `req`
+func searchAttributeValue(attr *searchAttribute, req string) *jen.Statement {
+	value := jen.Id(req).Dot(fmt.Sprintf("Get%s", attr.field.GoName)).Call()
+
+	switch attr.valueType {
+	case temporalv1.IndexedValueType_INDEXED_VALUE_TYPE_INT:
+		return jen.Int64().Call(value)
+	case temporalv1.IndexedValueType_INDEXED_VALUE_TYPE_DOUBLE:
+		return jen.Float64().Call(value)
+	case temporalv1.IndexedValueType_INDEXED_VALUE_TYPE_DATETIME:
+		return value.Dot("AsTime").Call()
+	}
+
+	if attr.field.Desc.Kind() == protoreflect.EnumKind {
+		return value.Dot("String").Call()
+	}
+
+	return value
+}
+
+// hasSearchAttributes returns true if the input of the method declares search attributes,
+// invalid declarations are reported by ServiceSearchAttributes
+func hasSearchAttributes(method *protogen.Method) bool {
+	attributes, err := getSearchAttributes(method.Input)
+	return err == nil && len(attributes) != 0
+}
+
+func ServiceSearchAttributes(gf *protogen.GeneratedFile, service *protogen.Service) error {
+	searchAttributes := jen.Null()
+
+	keys := make([]jen.Code, 0)
+	keysTypes := make(map[string]temporalv1.IndexedValueType)
+
+	for _, method := range service.Methods {
+		t, err := getMethodType(method)
+		if err != nil {
+			return err
+		}
+
+		if t != MethodTypeWorkflow {
+			continue
+		}
+
+		attributes, err := getSearchAttributes(method.Input)
+		if err != nil {
+			return err
+		}
+
+		if len(attributes) == 0 {
+			continue
+		}
+
+		for _, attr := range attributes {
+			if valueType, ok := keysTypes[attr.name]; ok {
+				if valueType != attr.valueType {
+					return fmt.Errorf("search attribute %s of service %s is declared with both the %s and %s types", attr.name, service.GoName, valueType, attr.valueType)
+				}
+				continue
+			}
+			keysTypes[attr.name] = attr.valueType
+
+			keyName := getSearchAttributeKeyName(service, attr.name)
+			keys = append(keys, jen.Comment(fmt.Sprintf("%s is the key of the %s search attribute, of type %s", keyName, attr.name, strings.TrimPrefix(attr.valueType.String(), "INDEXED_VALUE_TYPE_"))).Line().
+				Id(keyName).Op("=").Id(getTemporalObject(gf, keyConstructors[attr.valueType])).Call(jen.Lit(attr.name)))
+		}
+
+		updatesName := getSearchAttributeUpdatesName(service, method)
+
+		searchAttributes.Comment(fmt.Sprintf("%s returns the search attributes derived from a %s request", updatesName, method.GoName)).Line().
+			Func().Id(updatesName).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent)))
+		}).Index().Id(getTemporalObject(gf, "SearchAttributeUpdate")).BlockFunc(func(g *jen.Group) {
+			g.Add(jen.Id("updates").Op(":=").Index().Id(getTemporalObject(gf, "SearchAttributeUpdate")).ValuesFunc(func(g *jen.Group) {
+				for _, attr := range attributes {
+					if attr.valueType == temporalv1.IndexedValueType_INDEXED_VALUE_TYPE_DATETIME {
+						continue
+					}
+					g.Line().Id(getSearchAttributeKeyName(service, attr.name)).Dot("ValueSet").Call(searchAttributeValue(attr, "req"))
+				}
+				g.Line()
+			}))
+
+			// unset timestamps would be indexed as the epoch otherwise
+			for _, attr := range attributes {
+				if attr.valueType != temporalv1.IndexedValueType_INDEXED_VALUE_TYPE_DATETIME {
+					continue
+				}
+				g.Add(jen.If(jen.Id("req").Dot(fmt.Sprintf("Get%s", attr.field.GoName)).Call().Op("!=").Nil()).Block(
+					jen.Id("updates").Op("=").Append(jen.Id("updates"), jen.Id(getSearchAttributeKeyName(service, attr.name)).Dot("ValueSet").Call(searchAttributeValue(attr, "req"))),
+				))
+			}
+
+			g.Add(jen.Return(jen.Id("updates")))
+		}).Line()
+
+		searchAttributes.Comment(fmt.Sprintf("Upsert%sSearchAttributes upserts the search attributes derived from a %s request", method.GoName, method.GoName)).Line().
+			Comment("This is called within your workflow to keep the search attributes up to date").Line().
+			Func().Id(fmt.Sprintf("Upsert%sSearchAttributes", method.GoName)).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Id("ctx").Id(getTemporalWorkflowObject(gf, "Context")))
+			g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent)))
+		}).Error().BlockFunc(func(g *jen.Group) {
+			g.Add(jen.Return(jen.Id(getTemporalWorkflowObject(gf, "UpsertTypedSearchAttributes")).Call(
+				jen.Id("ctx"),
+				jen.Id(updatesName).Call(jen.Id("req")).Op("..."),
+			)))
+		}).Line()
+	}
+
+	if len(keysTypes) == 0 {
+		return nil
+	}
+
+	block := jen.Comment(fmt.Sprintf("Search attributes keys of the %s service", service.GoName)).Line().
+		Var().Defs(keys...).Line().Line().
+		Add(searchAttributes)
+
+	buf := bytes.NewBufferString("")
+	if err := block.Render(buf); err != nil {
+		return err
+	}
+
+	gf.P(buf.String())
+
+	return nil
+}
+
+// searchAttributesDefault returns the statement setting the search attributes of `options`
+// from the request when the caller did not provide any
+func searchAttributesDefault(gf *protogen.GeneratedFile, service *protogen.Service, method *protogen.Method, options *jen.Statement, req string) *jen.Statement {
+	return jen.If(options.Clone().Dot("TypedSearchAttributes").Dot("Size").Call().Op("==").Lit(0)).Block(
+		options.Clone().Dot("TypedSearchAttributes").Op("=").Id(getTemporalObject(gf, "NewSearchAttributes")).Call(
+			jen.Id(getSearchAttributeUpdatesName(service, method)).Call(jen.Id(req)).Op("..."),
+		),
+	)
+}
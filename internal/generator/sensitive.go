@@ -0,0 +1,340 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"strings"
+	"unicode"
+
+	"github.com/dave/jennifer/jen"
+	temporalv1 "github.com/thomas-maurice/protoc-gen-go-tmprl/gen/temporal/v1"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// getFilePrefix returns the CamelCase base name of a proto file, used to name
+// the objects generated once per file. `user_data.proto` becomes `UserData`
+func getFilePrefix(file *protogen.File) string {
+	base := strings.TrimSuffix(path.Base(file.Desc.Path()), ".proto")
+
+	parts := strings.FieldsFunc(base, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, part := range parts {
+		parts[i] = strings.ToUpper(part[:1]) + part[1:]
+	}
+
+	return strings.Join(parts, "")
+}
+
+func isSensitiveField(field *protogen.Field) bool {
+	sensitive, _ := proto.GetExtension(field.Desc.Options(), temporalv1.E_Sensitive).(bool)
+	return sensitive
+}
+
+// hasSensitiveFields returns true if a message has a sensitive field, either
+// directly or through one of its message fields
+func hasSensitiveFields(message *protogen.Message, visited map[protoreflect.FullName]bool) bool {
+	if visited[message.Desc.FullName()] {
+		return false
+	}
+	visited[message.Desc.FullName()] = true
+
+	for _, field := range message.Fields {
+		if isSensitiveField(field) {
+			return true
+		}
+
+		if field.Message != nil && hasSensitiveFields(field.Message, visited) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkSensitiveFields returns an error if a sensitive field of a message is
+// copied in clear text to the search attributes or the memo of a workflow
+func checkSensitiveFields(message *protogen.Message) error {
+	for _, field := range message.Fields {
+		if !isSensitiveField(field) {
+			continue
+		}
+
+		if opts, _ := proto.GetExtension(field.Desc.Options(), temporalv1.E_SearchAttribute).(*temporalv1.SearchAttributeOptions); opts != nil {
+			return fmt.Errorf("sensitive field %s cannot be used as a search attribute", field.Desc.FullName())
+		}
+
+		if opts, _ := proto.GetExtension(field.Desc.Options(), temporalv1.E_Memo).(*temporalv1.MemoOptions); opts != nil {
+			return fmt.Errorf("sensitive field %s cannot be used in a memo", field.Desc.FullName())
+		}
+	}
+
+	return nil
+}
+
+// getSensitiveMessages returns the messages of a file and the messages used by
+// its temporal services that contain sensitive fields, each of them once
+func getSensitiveMessages(file *protogen.File) ([]*protogen.Message, error) {
+	candidates := make([]*protogen.Message, 0)
+
+	var walk func(messages []*protogen.Message)
+	walk = func(messages []*protogen.Message) {
+		for _, message := range messages {
+			if message.Desc.IsMapEntry() {
+				continue
+			}
+
+			candidates = append(candidates, message)
+			walk(message.Messages)
+		}
+	}
+	walk(file.Messages)
+
+	for _, service := range file.Services {
+		if so, ok := proto.GetExtension(service.Desc.Options(), temporalv1.E_Service).(*temporalv1.ServiceOptions); !ok || so == nil {
+			continue
+		}
+
+		candidates = append(candidates, getServiceMessages(service)...)
+	}
+
+	messages := make([]*protogen.Message, 0)
+	seen := make(map[protoreflect.FullName]bool)
+
+	for _, message := range candidates {
+		if seen[message.Desc.FullName()] {
+			continue
+		}
+		seen[message.Desc.FullName()] = true
+
+		if err := checkSensitiveFields(message); err != nil {
+			return nil, err
+		}
+
+		if hasSensitiveFields(message, make(map[protoreflect.FullName]bool)) {
+			messages = append(messages, message)
+		}
+	}
+
+	return messages, nil
+}
+
+// SensitivePayloadCodec generates the payload codec encrypting the payloads of
+// the messages of a file containing sensitive fields, and the codec server
+// handler the Temporal UI can use to decode them
+func SensitivePayloadCodec(gf *protogen.GeneratedFile, file *protogen.File) error {
+	messages, err := getSensitiveMessages(file)
+	if err != nil {
+		return err
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+
+	prefix := getFilePrefix(file)
+	keyProviderName := fmt.Sprintf("%sKeyProvider", prefix)
+	staticKeyProviderName := fmt.Sprintf("%sStaticKeyProvider", prefix)
+	codecName := fmt.Sprintf("%sPayloadCodec", prefix)
+	messageTypesName := fmt.Sprintf("%sSensitiveMessageTypes", prefix)
+	encodingName := fmt.Sprintf("%sEncryptedEncoding", prefix)
+	keyIDName := fmt.Sprintf("%sEncryptionKeyIDMetadata", prefix)
+	aeadName := fmt.Sprintf("new%sAEAD", prefix)
+
+	payloads := jen.Index().Op("*").Id(getTemporalCommonObject(gf, "Payload"))
+	metadata := func(name string) *jen.Statement {
+		return jen.Id("p").Dot("GetMetadata").Call().Index(jen.Id(name))
+	}
+
+	codec := jen.Null()
+
+	codec.Const().DefsFunc(func(g *jen.Group) {
+		g.Comment(fmt.Sprintf("%s is the encoding of the payloads encrypted by the %s", encodingName, codecName))
+		g.Add(jen.Id(encodingName).Op("=").Lit("binary/encrypted"))
+		g.Comment(fmt.Sprintf("%s is the metadata holding the id of the key a payload is encrypted with", keyIDName))
+		g.Add(jen.Id(keyIDName).Op("=").Lit("encryption-key-id"))
+	}).Line().Line()
+
+	codec.Comment(fmt.Sprintf("%s are the types of the messages containing sensitive fields,", messageTypesName)).Line().
+		Comment(fmt.Sprintf("their payloads are encrypted by the %s", codecName)).Line().
+		Var().Id(messageTypesName).Op("=").Map(jen.String()).Bool().Values(jen.DictFunc(func(d jen.Dict) {
+		for _, message := range messages {
+			d[jen.Lit(string(message.Desc.FullName()))] = jen.True()
+		}
+	})).Line().Line()
+
+	codec.Comment(fmt.Sprintf("%s provides the AES keys of the %s, a key must be", keyProviderName, codecName)).Line().
+		Comment("16, 24 or 32 bytes long to select AES-128, AES-192 or AES-256").Line().
+		Type().Id(keyProviderName).Interface(
+		jen.Comment("EncryptionKey returns the key new payloads are encrypted with and its id"),
+		jen.Id("EncryptionKey").Params().Params(jen.String(), jen.Index().Byte(), jen.Error()),
+		jen.Comment("DecryptionKey returns the key of the given id"),
+		jen.Id("DecryptionKey").Params(jen.Id("id").String()).Params(jen.Index().Byte(), jen.Error()),
+	).Line().Line()
+
+	codec.Comment(fmt.Sprintf("%s is a %s using a single key", staticKeyProviderName, keyProviderName)).Line().
+		Type().Id(staticKeyProviderName).Struct(
+		jen.Id("ID").String(),
+		jen.Id("Key").Index().Byte(),
+	).Line().Line()
+
+	codec.Comment("EncryptionKey returns the key of the provider").Line().
+		Func().Parens(jen.Id("p").Id(staticKeyProviderName)).Id("EncryptionKey").Params().Params(jen.String(), jen.Index().Byte(), jen.Error()).Block(
+		jen.Return(jen.Id("p").Dot("ID"), jen.Id("p").Dot("Key"), jen.Nil()),
+	).Line().Line()
+
+	codec.Comment("DecryptionKey returns the key of the provider if its id matches").Line().
+		Func().Parens(jen.Id("p").Id(staticKeyProviderName)).Id("DecryptionKey").Params(jen.Id("id").String()).Params(jen.Index().Byte(), jen.Error()).Block(
+		jen.If(jen.Id("id").Op("!=").Id("p").Dot("ID")).Block(
+			jen.Return(jen.Nil(), jen.Id(getFmtObject(gf, "Errorf")).Call(jen.Lit("unknown encryption key %q"), jen.Id("id"))),
+		),
+		jen.Return(jen.Id("p").Dot("Key"), jen.Nil()),
+	).Line().Line()
+
+	codec.Comment(fmt.Sprintf("%s is a payload codec encrypting with AES-GCM the payloads of the messages", codecName)).Line().
+		Comment(fmt.Sprintf("listed in %s, the other payloads are left as they are", messageTypesName)).Line().
+		Type().Id(codecName).Struct(
+		jen.Id("keys").Id(keyProviderName),
+	).Line().Line()
+
+	codec.Comment(fmt.Sprintf("New%s returns a payload codec encrypting the sensitive payloads with the keys of the provider", codecName)).Line().
+		Func().Id(fmt.Sprintf("New%s", codecName)).Params(jen.Id("keys").Id(keyProviderName)).Op("*").Id(codecName).Block(
+		jen.Return(jen.Op("&").Id(codecName).Values(jen.Dict{
+			jen.Id("keys"): jen.Id("keys"),
+		})),
+	).Line().Line()
+
+	codec.Func().Id(aeadName).Params(jen.Id("key").Index().Byte()).Params(jen.Id(getCipherObject(gf, "AEAD")), jen.Error()).BlockFunc(func(g *jen.Group) {
+		g.Add(jen.List(jen.Id("block"), jen.Err()).Op(":=").Id(getAESObject(gf, "NewCipher")).Call(jen.Id("key")))
+		g.Add(IfErrNilDouble)
+		g.Line()
+		g.Add(jen.Return(jen.Id(getCipherObject(gf, "NewGCM")).Call(jen.Id("block"))))
+	}).Line().Line()
+
+	codec.Comment("Encode encrypts the payloads of the messages containing sensitive fields").Line().
+		Func().Parens(jen.Id("c").Op("*").Id(codecName)).Id("Encode").Params(jen.Id("payloads").Add(payloads)).Params(payloads, jen.Error()).BlockFunc(func(g *jen.Group) {
+		g.Add(jen.Id("result").Op(":=").Make(payloads, jen.Len(jen.Id("payloads"))))
+		g.Add(jen.For(jen.List(jen.Id("i"), jen.Id("p")).Op(":=").Range().Id("payloads")).BlockFunc(func(g *jen.Group) {
+			g.Add(jen.If(jen.Op("!").Id(messageTypesName).Index(jen.String().Call(metadata(getTemporalConverterObject(gf, "MetadataMessageType"))))).Block(
+				jen.Id("result").Index(jen.Id("i")).Op("=").Id("p"),
+				jen.Continue(),
+			))
+			g.Line()
+			g.Add(jen.List(jen.Id("id"), jen.Id("key"), jen.Err()).Op(":=").Id("c").Dot("keys").Dot("EncryptionKey").Call())
+			g.Add(jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Nil(), jen.Id(getFmtObject(gf, "Errorf")).Call(jen.Lit("could not get the encryption key: %w"), jen.Err())),
+			))
+			g.Line()
+			g.Add(jen.List(jen.Id("aead"), jen.Err()).Op(":=").Id(aeadName).Call(jen.Id("key")))
+			g.Add(jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Nil(), jen.Id(getFmtObject(gf, "Errorf")).Call(jen.Lit("invalid encryption key %q: %w"), jen.Id("id"), jen.Err())),
+			))
+			g.Line()
+			g.Add(jen.List(jen.Id("data"), jen.Err()).Op(":=").Id(getProtoObject(gf, "Marshal")).Call(jen.Id("p")))
+			g.Add(IfErrNilDouble)
+			g.Line()
+			g.Add(jen.Id("nonce").Op(":=").Make(jen.Index().Byte(), jen.Id("aead").Dot("NonceSize").Call()))
+			g.Add(jen.If(
+				jen.List(jen.Id("_"), jen.Err()).Op(":=").Id(getIOObject(gf, "ReadFull")).Call(jen.Id(getRandObject(gf, "Reader")), jen.Id("nonce")),
+				jen.Err().Op("!=").Nil(),
+			).Block(
+				jen.Return(jen.Nil(), jen.Err()),
+			))
+			g.Line()
+			g.Add(jen.Id("result").Index(jen.Id("i")).Op("=").Op("&").Id(getTemporalCommonObject(gf, "Payload")).Values(jen.Dict{
+				jen.Id("Metadata"): jen.Map(jen.String()).Index().Byte().Values(jen.Dict{
+					jen.Id(getTemporalConverterObject(gf, "MetadataEncoding")): jen.Index().Byte().Call(jen.Id(encodingName)),
+					jen.Id(keyIDName): jen.Index().Byte().Call(jen.Id("id")),
+				}),
+				jen.Id("Data"): jen.Id("aead").Dot("Seal").Call(jen.Id("nonce"), jen.Id("nonce"), jen.Id("data"), jen.Nil()),
+			}))
+		}))
+		g.Line()
+		g.Add(jen.Return(jen.Id("result"), jen.Nil()))
+	}).Line().Line()
+
+	codec.Comment("Decode decrypts the payloads encrypted by Encode").Line().
+		Func().Parens(jen.Id("c").Op("*").Id(codecName)).Id("Decode").Params(jen.Id("payloads").Add(payloads)).Params(payloads, jen.Error()).BlockFunc(func(g *jen.Group) {
+		g.Add(jen.Id("result").Op(":=").Make(payloads, jen.Len(jen.Id("payloads"))))
+		g.Add(jen.For(jen.List(jen.Id("i"), jen.Id("p")).Op(":=").Range().Id("payloads")).BlockFunc(func(g *jen.Group) {
+			g.Add(jen.If(jen.String().Call(metadata(getTemporalConverterObject(gf, "MetadataEncoding"))).Op("!=").Id(encodingName)).Block(
+				jen.Id("result").Index(jen.Id("i")).Op("=").Id("p"),
+				jen.Continue(),
+			))
+			g.Line()
+			g.Add(jen.Id("id").Op(":=").String().Call(metadata(keyIDName)))
+			g.Add(jen.List(jen.Id("key"), jen.Err()).Op(":=").Id("c").Dot("keys").Dot("DecryptionKey").Call(jen.Id("id")))
+			g.Add(jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Nil(), jen.Id(getFmtObject(gf, "Errorf")).Call(jen.Lit("could not get the decryption key %q: %w"), jen.Id("id"), jen.Err())),
+			))
+			g.Line()
+			g.Add(jen.List(jen.Id("aead"), jen.Err()).Op(":=").Id(aeadName).Call(jen.Id("key")))
+			g.Add(jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Nil(), jen.Id(getFmtObject(gf, "Errorf")).Call(jen.Lit("invalid decryption key %q: %w"), jen.Id("id"), jen.Err())),
+			))
+			g.Line()
+			g.Add(jen.If(jen.Len(jen.Id("p").Dot("GetData").Call()).Op("<").Id("aead").Dot("NonceSize").Call()).Block(
+				jen.Return(jen.Nil(), jen.Id(getErrorsObject(gf, "New")).Call(jen.Lit("encrypted payload too short"))),
+			))
+			g.Line()
+			g.Add(jen.List(jen.Id("nonce"), jen.Id("ciphertext")).Op(":=").List(
+				jen.Id("p").Dot("GetData").Call().Index(jen.Empty(), jen.Id("aead").Dot("NonceSize").Call()),
+				jen.Id("p").Dot("GetData").Call().Index(jen.Id("aead").Dot("NonceSize").Call(), jen.Empty()),
+			))
+			g.Add(jen.List(jen.Id("data"), jen.Err()).Op(":=").Id("aead").Dot("Open").Call(jen.Nil(), jen.Id("nonce"), jen.Id("ciphertext"), jen.Nil()))
+			g.Add(jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Nil(), jen.Id(getFmtObject(gf, "Errorf")).Call(jen.Lit("could not decrypt payload: %w"), jen.Err())),
+			))
+			g.Line()
+			g.Add(jen.Id("decoded").Op(":=").Op("&").Id(getTemporalCommonObject(gf, "Payload")).Values())
+			g.Add(jen.If(jen.Err().Op(":=").Id(getProtoObject(gf, "Unmarshal")).Call(jen.Id("data"), jen.Id("decoded")), jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Nil(), jen.Err()),
+			))
+			g.Add(jen.Id("result").Index(jen.Id("i")).Op("=").Id("decoded"))
+		}))
+		g.Line()
+		g.Add(jen.Return(jen.Id("result"), jen.Nil()))
+	}).Line().Line()
+
+	codec.Comment(fmt.Sprintf("New%sCodecHandler returns the handler of a codec server decoding the payloads", prefix)).Line().
+		Comment(fmt.Sprintf("encrypted by the %s, to be set as the codec endpoint of the Temporal UI.", codecName)).Line().
+		Comment("`origins` are the origins of the Temporal UI allowed to call it from a browser").Line().
+		Func().Id(fmt.Sprintf("New%sCodecHandler", prefix)).Params(
+		jen.Id("keys").Id(keyProviderName),
+		jen.Id("origins").Op("...").String(),
+	).Id(getHTTPObject(gf, "Handler")).BlockFunc(func(g *jen.Group) {
+		g.Add(jen.Id("handler").Op(":=").Id(getTemporalConverterObject(gf, "NewPayloadCodecHTTPHandler")).Call(jen.Id(fmt.Sprintf("New%s", codecName)).Call(jen.Id("keys"))))
+		g.Line()
+		g.Add(jen.Return(jen.Id(getHTTPObject(gf, "HandlerFunc")).Call(jen.Func().Params(
+			jen.Id("w").Id(getHTTPObject(gf, "ResponseWriter")),
+			jen.Id("r").Op("*").Id(getHTTPObject(gf, "Request")),
+		).BlockFunc(func(g *jen.Group) {
+			g.Add(jen.For(jen.List(jen.Id("_"), jen.Id("origin")).Op(":=").Range().Id("origins")).Block(
+				jen.If(jen.Id("r").Dot("Header").Dot("Get").Call(jen.Lit("Origin")).Op("==").Id("origin")).Block(
+					jen.Id("w").Dot("Header").Call().Dot("Set").Call(jen.Lit("Access-Control-Allow-Origin"), jen.Id("origin")),
+					jen.Id("w").Dot("Header").Call().Dot("Set").Call(jen.Lit("Access-Control-Allow-Credentials"), jen.Lit("true")),
+					jen.Id("w").Dot("Header").Call().Dot("Set").Call(jen.Lit("Access-Control-Allow-Headers"), jen.Lit("Content-Type,X-Namespace,Authorization")),
+				),
+			))
+			g.Line()
+			g.Add(jen.If(jen.Id("r").Dot("Method").Op("==").Id(getHTTPObject(gf, "MethodOptions"))).Block(
+				jen.Id("w").Dot("WriteHeader").Call(jen.Id(getHTTPObject(gf, "StatusOK"))),
+				jen.Return(),
+			))
+			g.Line()
+			g.Add(jen.Id("handler").Dot("ServeHTTP").Call(jen.Id("w"), jen.Id("r")))
+		}))))
+	}).Line()
+
+	buf := bytes.NewBufferString("")
+	if err := codec.Render(buf); err != nil {
+		return err
+	}
+
+	gf.P(buf.String())
+
+	return nil
+}
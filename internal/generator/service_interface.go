@@ -65,6 +65,7 @@ func UnimplementedServiceInterface(gf *protogen.GeneratedFile, service *protogen
 				}).Line()
 		case MethodTypeSignal:
 		case MethodTypeQuery:
+		case MethodTypeUpdate:
 		default:
 			return fmt.Errorf("invalid method type: %s", t)
 		}
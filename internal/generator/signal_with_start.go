@@ -0,0 +1,136 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func ServiceSignalsWithStart(gf *protogen.GeneratedFile, service *protogen.Service, config *Config) error {
+	clientName := getClientName(service)
+
+	signalsWithStart := jen.Null()
+
+	for _, method := range service.Methods {
+		t, err := getMethodType(method)
+		if err != nil {
+			return err
+		}
+
+		if t != MethodTypeWorkflow {
+			continue
+		}
+
+		methName, err := getMethodRegisteredName(method)
+		if err != nil {
+			return err
+		}
+
+		// signals are looked up the same way the workflow objects do
+		signals := getDefaultWorkflowOptions(service).GetSignals()
+		if opts := getWorkflowOptions(method); opts != nil {
+			signals = opts.Signals
+		}
+
+		workflowOptions := getMergedWorkflowOptions(service, method)
+		workflowOptions.RetryPolicy, err = config.registry.expandRetryPolicy(service, workflowOptions.RetryPolicy)
+		if err != nil {
+			return fmt.Errorf("invalid retry policy for %s: %w", method.Desc.FullName(), err)
+		}
+
+		var workflowID *jen.Statement
+		if workflowOptions.IdTemplate != "" {
+			workflowID, err = workflowIDFromTemplate(gf, method, workflowOptions.IdTemplate, "wfReq")
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, sig := range signals {
+			sigMeth, err := config.registry.findMethod(service, sig, MethodTypeSignal)
+			if err != nil {
+				return fmt.Errorf("invalid workflow %s: %w", method.GoName, err)
+			}
+
+			sigName, err := getMethodRegisteredName(sigMeth)
+			if err != nil {
+				return err
+			}
+
+			hook, err := getClientHook(gf, service, config, fmt.Sprintf("SignalWithStart%s%s", method.GoName, sigMeth.GoName))
+			if err != nil {
+				return err
+			}
+
+			signalsWithStart.Comment(fmt.Sprintf("%s %s", hook.name, hook.doc)).Line().
+				Comment("If `workflowID` is empty, the ID is derived the same way as ExecuteWorkflow would").Line().
+				Func().Parens(jen.Id("c").Op("*").Id(clientName)).Id(hook.name).ParamsFunc(func(g *jen.Group) {
+				g.Add(jen.Id("ctx").Id(getContext(gf)))
+				g.Add(jen.Id("workflowID").String())
+				g.Add(jen.Id("sigReq").Op("*").Id(gf.QualifiedGoIdent(sigMeth.Input.GoIdent)))
+				g.Add(jen.Id("wfReq").Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent)))
+				g.Add(jen.Id("options").Op("...").Id(getTemporalClientObject(gf, "StartWorkflowOptions")))
+			}).ParamsFunc(func(g *jen.Group) {
+				g.Add(jen.Op("*").Id(getWorkflowObjectName(service, method)))
+				g.Add(jen.Error())
+			}).BlockFunc(func(g *jen.Group) {
+				g.Add(jen.Id("opts").Op(":=").Id(getTemporalClientObject(gf, "StartWorkflowOptions")).Block())
+				g.Add(jen.If(jen.Len(jen.Id("options")).Op(">").Lit(0).Block(
+					jen.Id("opts").Op("=").Id("options").Index(jen.Lit(0)),
+				)))
+				g.Add(jen.If(jen.Id("workflowID").Op("!=").Lit("")).Block(
+					jen.Id("opts").Dot("ID").Op("=").Id("workflowID"),
+				))
+
+				for _, s := range hook.chain(jen.Id("run"), jen.Err()) {
+					g.Add(s)
+				}
+
+				g.Add(IfErrNilDouble)
+
+				g.Add(jen.Return(jen.Id("c").Dot(fmt.Sprintf("Get%sFromRun", method.GoName)).Call(jen.Id("run")), jen.Nil()))
+			}).Line()
+
+			signalsWithStart.Comment(fmt.Sprintf("%s sends the signal and starts the workflow once the client interceptors ran", hook.getImplName())).Line().
+				Func().Parens(jen.Id("c").Op("*").Id(clientName)).Id(hook.getImplName()).ParamsFunc(func(g *jen.Group) {
+				g.Add(jen.Id("ctx").Id(getContext(gf)))
+				g.Add(jen.Id("sigReq").Op("*").Id(gf.QualifiedGoIdent(sigMeth.Input.GoIdent)))
+				g.Add(jen.Id("wfReq").Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent)))
+				g.Add(jen.Id("wOptions").Id(getTemporalClientObject(gf, "StartWorkflowOptions")))
+			}).ParamsFunc(func(g *jen.Group) {
+				g.Add(jen.Id(getTemporalClientObject(gf, "WorkflowRun")))
+				g.Add(jen.Error())
+			}).BlockFunc(func(g *jen.Group) {
+				if validatesBeforeSending(sigMeth, config) {
+					g.Add(validateRequest(service, sigName, "sigReq", jen.Return(jen.Nil(), jen.Err())))
+				}
+				if validatesBeforeSending(method, config) {
+					g.Add(validateRequest(service, methName, "wfReq", jen.Return(jen.Nil(), jen.Err())))
+				}
+
+				startWorkflowOptionsDefaults(gf, g, service, method, methName, workflowOptions, workflowID, "wfReq", config)
+
+				g.Add(jen.Return(jen.Id("c").Dot("client").Dot("SignalWithStartWorkflow").CallFunc(func(g *jen.Group) {
+					g.Add(jen.Id("ctx"))
+					g.Add(jen.Id("wOptions").Dot("ID"))
+					g.Add(jen.Lit(sigName))
+					g.Add(jen.Id("sigReq"))
+					g.Add(jen.Id("wOptions"))
+					g.Add(jen.Lit(methName))
+					g.Add(jen.Id("wfReq"))
+				})))
+			}).Line()
+		}
+	}
+
+	buf := bytes.NewBufferString("")
+	if err := signalsWithStart.Render(buf); err != nil {
+		return err
+	}
+
+	gf.P(buf.String())
+
+	return nil
+}
@@ -10,7 +10,7 @@ import (
 	"google.golang.org/protobuf/proto"
 )
 
-func ServiceSignals(gf *protogen.GeneratedFile, service *protogen.Service) error {
+func ServiceSignals(gf *protogen.GeneratedFile, service *protogen.Service, config *Config) error {
 	clientName := getClientName(service)
 
 	signals := jen.Null()
@@ -32,30 +32,20 @@ func ServiceSignals(gf *protogen.GeneratedFile, service *protogen.Service) error
 
 		sigOpts, _ := proto.GetExtension(method.Desc.Options(), temporalv1.E_Signal).(*temporalv1.SignalOptions)
 
+		hook, err := getClientHook(gf, service, config, fmt.Sprintf("SendSignal%s", method.GoName))
+		if err != nil {
+			return err
+		}
+
 		signals.Comment(fmt.Sprintf("SendSignal%s sends the %s signal to a workflow", method.GoName, method.GoName)).Line().
-			Func().Parens(jen.Id("c").Op("*").Id(clientName)).Id(fmt.Sprintf("SendSignal%s", method.GoName)).ParamsFunc(func(g *jen.Group) {
-			g.Add(jen.Id("ctx").Id(getContext(gf)))
-			g.Add(jen.Id("workflowID").String())
-			g.Add(jen.Id("runID").String())
-			g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent)))
-		}).ParamsFunc(func(g *jen.Group) {
-			g.Add(jen.Error())
-		}).BlockFunc(func(g *jen.Group) {
-			sigName := methName
-			if sigOpts != nil && sigOpts.Name != "" {
-				sigName = sigOpts.Name
-			}
+			Add(hook.method(clientName)).Line().Line()
 
-			g.Add(jen.Return(
-				jen.Id("c").Dot("client").Dot("SignalWorkflow").CallFunc(func(g *jen.Group) {
-					g.Add(jen.Id("ctx"))
-					g.Add(jen.Id("workflowID"))
-					g.Add(jen.Id("runID"))
-					g.Add(jen.Lit(sigName))
-					g.Add(jen.Id("req"))
-				})))
+		impl, err := signalImpl(gf, service, hook, config)
+		if err != nil {
+			return err
+		}
 
-		}).Line()
+		signals.Add(impl)
 
 		signals.Comment(fmt.Sprintf("ReceiveSignal%s waits for the the %s signal", method.GoName, method.GoName)).Line().
 			Func().Id(fmt.Sprintf("ReceiveSignal%s", method.GoName)).ParamsFunc(func(g *jen.Group) {
@@ -113,6 +103,8 @@ func ServiceSignals(gf *protogen.GeneratedFile, service *protogen.Service) error
 			}))
 
 		}).Line()
+
+		signals.Add(signalChannel(gf, service, method, methName, sigOpts))
 	}
 
 	buf := bytes.NewBufferString("")
@@ -124,3 +116,108 @@ func ServiceSignals(gf *protogen.GeneratedFile, service *protogen.Service) error
 
 	return nil
 }
+
+func getSignalChannelName(service *protogen.Service, method *protogen.Method) string {
+	return fmt.Sprintf("%s%sSignalChannel", service.GoName, method.GoName)
+}
+
+// signalChannel generates a typed wrapper around the channel of a signal,
+// so it can be used along with timers and futures in a workflow.Selector
+// signalImpl returns the unexported method of the client sending a signal
+// once the client interceptors ran
+func signalImpl(gf *protogen.GeneratedFile, service *protogen.Service, hook *clientHook, config *Config) (*jen.Statement, error) {
+	sigName, err := getMethodRegisteredName(hook.rpc)
+	if err != nil {
+		return nil, err
+	}
+
+	params, _, results := hook.signature()
+
+	return jen.Comment(fmt.Sprintf("%s sends the signal once the client interceptors ran", hook.getImplName())).Line().
+		Func().Parens(jen.Id("c").Op("*").Id(getClientName(service))).Id(hook.getImplName()).Params(params...).Params(results...).BlockFunc(func(g *jen.Group) {
+		if validatesBeforeSending(hook.rpc, config) {
+			g.Add(validateRequest(service, sigName, "req", jen.Return(jen.Err())))
+		}
+
+		g.Add(jen.Return(jen.Id("c").Dot("client").Dot("SignalWorkflow").Call(
+			jen.Id("ctx"),
+			jen.Id("workflowID"),
+			jen.Id("runID"),
+			jen.Lit(sigName),
+			jen.Id("req"),
+		)))
+	}).Line(), nil
+}
+
+func signalChannel(gf *protogen.GeneratedFile, service *protogen.Service, method *protogen.Method, methName string, sigOpts *temporalv1.SignalOptions) *jen.Statement {
+	channelName := getSignalChannelName(service, method)
+	reqType := gf.QualifiedGoIdent(method.Input.GoIdent)
+
+	sigName := methName
+	if sigOpts != nil && sigOpts.Name != "" {
+		sigName = sigOpts.Name
+	}
+
+	return jen.Comment(fmt.Sprintf("%s is a typed wrapper around the channel of the %s signal", channelName, method.GoName)).Line().
+		Type().Id(channelName).StructFunc(func(g *jen.Group) {
+		g.Add(jen.Id("channel").Id(getTemporalWorkflowObject(gf, "ReceiveChannel")))
+	}).Line().Line().
+		Comment(fmt.Sprintf("Signal%sChannel returns the channel of the %s signal", method.GoName, method.GoName)).Line().
+		Func().Id(fmt.Sprintf("Signal%sChannel", method.GoName)).ParamsFunc(func(g *jen.Group) {
+		g.Add(jen.Id("ctx").Id(getTemporalWorkflowObject(gf, "Context")))
+	}).Op("*").Id(channelName).BlockFunc(func(g *jen.Group) {
+		g.Add(jen.Return(jen.Op("&").Id(channelName).Values(jen.Dict{
+			jen.Id("channel"): jen.Id(getTemporalWorkflowObject(gf, "GetSignalChannel")).Call(jen.Id("ctx"), jen.Lit(sigName)),
+		})))
+	}).Line().Line().
+		Comment("Receive blocks until a signal is received, the boolean is false if the channel was closed").Line().
+		Func().Parens(jen.Id("c").Op("*").Id(channelName)).Id("Receive").ParamsFunc(func(g *jen.Group) {
+		g.Add(jen.Id("ctx").Id(getTemporalWorkflowObject(gf, "Context")))
+	}).ParamsFunc(func(g *jen.Group) {
+		g.Add(jen.Op("*").Id(reqType))
+		g.Add(jen.Bool())
+	}).BlockFunc(func(g *jen.Group) {
+		g.Add(jen.Var().Id("result").Op("*").Id(reqType))
+		g.Add(jen.Id("more").Op(":=").Id("c").Dot("channel").Dot("Receive").Call(jen.Id("ctx"), jen.Op("&").Id("result")))
+		g.Add(jen.Return(jen.Id("result"), jen.Id("more")))
+	}).Line().Line().
+		Comment("ReceiveAsync returns a pending signal without blocking, the boolean is false if there was none").Line().
+		Func().Parens(jen.Id("c").Op("*").Id(channelName)).Id("ReceiveAsync").Params().ParamsFunc(func(g *jen.Group) {
+		g.Add(jen.Op("*").Id(reqType))
+		g.Add(jen.Bool())
+	}).BlockFunc(func(g *jen.Group) {
+		g.Add(jen.Var().Id("result").Op("*").Id(reqType))
+		g.Add(jen.Id("ok").Op(":=").Id("c").Dot("channel").Dot("ReceiveAsync").Call(jen.Op("&").Id("result")))
+		g.Add(jen.Return(jen.Id("result"), jen.Id("ok")))
+	}).Line().Line().
+		Comment("ReceiveWithTimeout blocks until a signal is received or the timeout expires, the boolean is false if no signal was received").Line().
+		Func().Parens(jen.Id("c").Op("*").Id(channelName)).Id("ReceiveWithTimeout").ParamsFunc(func(g *jen.Group) {
+		g.Add(jen.Id("ctx").Id(getTemporalWorkflowObject(gf, "Context")))
+		g.Add(jen.Id("timeout").Id(getTimeObject(gf, "Duration")))
+	}).ParamsFunc(func(g *jen.Group) {
+		g.Add(jen.Op("*").Id(reqType))
+		g.Add(jen.Bool())
+	}).BlockFunc(func(g *jen.Group) {
+		g.Add(jen.Var().Id("result").Op("*").Id(reqType))
+		g.Add(jen.List(jen.Id("ok"), jen.Id("_")).Op(":=").Id("c").Dot("channel").Dot("ReceiveWithTimeout").Call(jen.Id("ctx"), jen.Id("timeout"), jen.Op("&").Id("result")))
+		g.Add(jen.Return(jen.Id("result"), jen.Id("ok")))
+	}).Line().Line().
+		Comment("Len returns the number of signals waiting to be received").Line().
+		Func().Parens(jen.Id("c").Op("*").Id(channelName)).Id("Len").Params().Int().Block(
+		jen.Return(jen.Id("c").Dot("channel").Dot("Len").Call()),
+	).Line().Line().
+		Comment("AddToSelector adds the channel to a selector, `f` is called with the signal when the selector picks it").Line().
+		Func().Parens(jen.Id("c").Op("*").Id(channelName)).Id("AddToSelector").ParamsFunc(func(g *jen.Group) {
+		g.Add(jen.Id("selector").Id(getTemporalWorkflowObject(gf, "Selector")))
+		g.Add(jen.Id("f").Func().Params(jen.Op("*").Id(reqType)))
+	}).Id(getTemporalWorkflowObject(gf, "Selector")).BlockFunc(func(g *jen.Group) {
+		g.Add(jen.Return(jen.Id("selector").Dot("AddReceive").Call(
+			jen.Id("c").Dot("channel"),
+			jen.Func().Params(jen.Id("channel").Id(getTemporalWorkflowObject(gf, "ReceiveChannel")), jen.Id("more").Bool()).BlockFunc(func(g *jen.Group) {
+				g.Add(jen.Var().Id("result").Op("*").Id(reqType))
+				g.Add(jen.Id("channel").Dot("ReceiveAsync").Call(jen.Op("&").Id("result")))
+				g.Add(jen.Id("f").Call(jen.Id("result")))
+			}),
+		)))
+	}).Line()
+}
@@ -0,0 +1,319 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+
+	"github.com/dave/jennifer/jen"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// getTestEnvPackageName returns the name of the package of the test
+// environments of a file
+func getTestEnvPackageName(file *protogen.File) protogen.GoPackageName {
+	return file.GoPackageName + "test"
+}
+
+// getTestEnvImportPath returns the import path of the package of the test
+// environments of a file, a sub package of the generated one
+func getTestEnvImportPath(file *protogen.File) protogen.GoImportPath {
+	return protogen.GoImportPath(path.Join(string(file.GoImportPath), string(getTestEnvPackageName(file))))
+}
+
+func getTestEnvName(service *protogen.Service) string {
+	return fmt.Sprintf("%sTestEnv", service.GoName)
+}
+
+func getMockCallName(service *protogen.Service, method *protogen.Method) string {
+	return fmt.Sprintf("%s%sMockCall", service.GoName, method.GoName)
+}
+
+// requestMatcher generates a mock argument matching a request by value, or
+// anything if the request is nil
+func requestMatcher(gf *protogen.GeneratedFile, method *protogen.Method) *jen.Statement {
+	return jen.Var().Id("reqMatcher").Interface().Op("=").Id(gf.QualifiedGoIdent(protogen.GoIdent{GoImportPath: mockImport, GoName: "Anything"})).Line().
+		If(jen.Id("req").Op("!=").Nil()).Block(
+		jen.Id("reqMatcher").Op("=").Id(gf.QualifiedGoIdent(protogen.GoIdent{GoImportPath: mockImport, GoName: "MatchedBy"})).Call(
+			jen.Func().Params(jen.Id("r").Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent))).Bool().Block(
+				jen.Return(jen.Id(gf.QualifiedGoIdent(protogen.GoIdent{GoImportPath: protoImport, GoName: "Equal"})).Call(jen.Id("r"), jen.Id("req"))),
+			),
+		),
+	)
+}
+
+// mockCall generates the typed wrapper around a testsuite.MockCallWrapper for a given workflow or activity
+func mockCall(gf *protogen.GeneratedFile, service *protogen.Service, method *protogen.Method) *jen.Statement {
+	mockCallName := getMockCallName(service, method)
+	mockCallWrapper := gf.QualifiedGoIdent(protogen.GoIdent{GoImportPath: testsuiteImport, GoName: "MockCallWrapper"})
+
+	return jen.Comment(fmt.Sprintf("%s is a typed mock of %s", mockCallName, method.GoName)).Line().
+		Type().Id(mockCallName).StructFunc(func(g *jen.Group) {
+		g.Add(jen.Id("call").Op("*").Id(mockCallWrapper))
+	}).Line().Line().
+		Comment("Return sets the values returned by the mock").Line().
+		Func().Parens(jen.Id("m").Op("*").Id(mockCallName)).Id("Return").ParamsFunc(func(g *jen.Group) {
+		g.Add(jen.Id("resp").Op("*").Id(gf.QualifiedGoIdent(method.Output.GoIdent)))
+		g.Add(jen.Id("err").Error())
+	}).Op("*").Id(mockCallName).BlockFunc(func(g *jen.Group) {
+		g.Add(jen.Id("m").Dot("call").Dot("Return").Call(jen.Id("resp"), jen.Id("err")))
+		g.Add(jen.Return(jen.Id("m")))
+	}).Line().Line().
+		Comment("Once indicates that the mock should only return the value once").Line().
+		Func().Parens(jen.Id("m").Op("*").Id(mockCallName)).Id("Once").Params().Op("*").Id(mockCallName).BlockFunc(func(g *jen.Group) {
+		g.Add(jen.Id("m").Dot("call").Dot("Once").Call())
+		g.Add(jen.Return(jen.Id("m")))
+	}).Line().Line().
+		Comment("Times indicates that the mock should only return the indicated number of times").Line().
+		Func().Parens(jen.Id("m").Op("*").Id(mockCallName)).Id("Times").Params(jen.Id("i").Int()).Op("*").Id(mockCallName).BlockFunc(func(g *jen.Group) {
+		g.Add(jen.Id("m").Dot("call").Dot("Times").Call(jen.Id("i")))
+		g.Add(jen.Return(jen.Id("m")))
+	}).Line().Line().
+		Comment("Call returns the underlying mock call, for the settings that are not wrapped").Line().
+		Func().Parens(jen.Id("m").Op("*").Id(mockCallName)).Id("Call").Params().Op("*").Id(mockCallWrapper).BlockFunc(func(g *jen.Group) {
+		g.Add(jen.Return(jen.Id("m").Dot("call")))
+	}).Line().Line()
+}
+
+func TestEnv(gf *protogen.GeneratedFile, file *protogen.File, service *protogen.Service, config *Config) error {
+	envName := getTestEnvName(service)
+	svcName := gf.QualifiedGoIdent(file.GoImportPath.Ident(getSvcName(service)))
+	registerName := gf.QualifiedGoIdent(file.GoImportPath.Ident(getRegisterServiceName(service)))
+
+	testEnv := jen.Comment(fmt.Sprintf("%s wraps a testsuite.TestWorkflowEnvironment with typed helpers for the %s service", envName, service.GoName)).Line().
+		Type().Id(envName).
+		StructFunc(func(g *jen.Group) {
+			g.Add(jen.Id("suite").Id(gf.QualifiedGoIdent(protogen.GoIdent{GoImportPath: testsuiteImport, GoName: "WorkflowTestSuite"})))
+			g.Add(jen.Id("env").Op("*").Id(gf.QualifiedGoIdent(protogen.GoIdent{GoImportPath: testsuiteImport, GoName: "TestWorkflowEnvironment"})))
+		}).Line().Line().
+		Comment(fmt.Sprintf("New%s returns a test environment with all the workflows and activities of the service registered", envName)).Line().
+		Comment(fmt.Sprintf("by %s, as a worker registers them.", getRegisterServiceName(service))).Line().
+		Comment("The expectations of the mocks are asserted when the test finishes").Line().
+		Func().Id(fmt.Sprintf("New%s", envName)).
+		ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Id("t").Id(gf.QualifiedGoIdent(protogen.GoIdent{GoImportPath: testingImport, GoName: "TB"})))
+			g.Add(jen.Id("svc").Id(svcName))
+		}).
+		Op("*").Id(envName).
+		BlockFunc(func(g *jen.Group) {
+			g.Add(jen.Id("e").Op(":=").Op("&").Id(envName).Block())
+			g.Add(jen.Id("e").Dot("env").Op("=").Id("e").Dot("suite").Dot("NewTestWorkflowEnvironment").Call())
+			g.Add(jen.Id(registerName).Call(jen.Id("e").Dot("env"), jen.Id("svc")))
+
+			g.Add(jen.Id("t").Dot("Cleanup").Call(jen.Func().Params().Block(
+				jen.Id("e").Dot("env").Dot("AssertExpectations").Call(jen.Id("t")),
+			)))
+			g.Add(jen.Return(jen.Id("e")))
+		}).Line().Line().
+		Comment("Env returns the underlying test environment").Line().
+		Func().Parens(jen.Id("e").Op("*").Id(envName)).Id("Env").Params().
+		Op("*").Id(gf.QualifiedGoIdent(protogen.GoIdent{GoImportPath: testsuiteImport, GoName: "TestWorkflowEnvironment"})).
+		BlockFunc(func(g *jen.Group) {
+			g.Add(jen.Return(jen.Id("e").Dot("env")))
+		}).Line().Line()
+
+	for _, method := range service.Methods {
+		t, err := getMethodType(method)
+		if err != nil {
+			return err
+		}
+
+		methName, err := getMethodRegisteredName(method)
+		if err != nil {
+			return err
+		}
+
+		switch t {
+		case MethodTypeActivity:
+			testEnv.Add(mockCall(gf, service, method))
+
+			testEnv.Comment(fmt.Sprintf("OnActivity%s mocks the %s activity, a nil request matches any request", method.GoName, method.GoName)).Line().
+				Func().Parens(jen.Id("e").Op("*").Id(envName)).Id(fmt.Sprintf("OnActivity%s", method.GoName)).ParamsFunc(func(g *jen.Group) {
+				g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent)))
+			}).Op("*").Id(getMockCallName(service, method)).BlockFunc(func(g *jen.Group) {
+				g.Add(requestMatcher(gf, method))
+				g.Add(jen.Return(jen.Op("&").Id(getMockCallName(service, method)).Values(jen.Dict{
+					jen.Id("call"): jen.Id("e").Dot("env").Dot("OnActivity").Call(
+						jen.Lit(methName),
+						jen.Id(gf.QualifiedGoIdent(protogen.GoIdent{GoImportPath: mockImport, GoName: "Anything"})),
+						jen.Id("reqMatcher"),
+					),
+				})))
+			}).Line().Line()
+		case MethodTypeWorkflow:
+			testEnv.Add(mockCall(gf, service, method))
+
+			testEnv.Comment(fmt.Sprintf("OnWorkflow%s mocks the %s workflow when it runs as a child, a nil request matches any request", method.GoName, method.GoName)).Line().
+				Func().Parens(jen.Id("e").Op("*").Id(envName)).Id(fmt.Sprintf("OnWorkflow%s", method.GoName)).ParamsFunc(func(g *jen.Group) {
+				g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent)))
+			}).Op("*").Id(getMockCallName(service, method)).BlockFunc(func(g *jen.Group) {
+				g.Add(requestMatcher(gf, method))
+				g.Add(jen.Return(jen.Op("&").Id(getMockCallName(service, method)).Values(jen.Dict{
+					jen.Id("call"): jen.Id("e").Dot("env").Dot("OnWorkflow").Call(
+						jen.Lit(methName),
+						jen.Id(gf.QualifiedGoIdent(protogen.GoIdent{GoImportPath: mockImport, GoName: "Anything"})),
+						jen.Id("reqMatcher"),
+					),
+				})))
+			}).Line().Line()
+
+			testEnv.Comment(fmt.Sprintf("ExecuteWorkflow%s executes the %s workflow in the test environment, blocking until it completes", method.GoName, method.GoName)).Line().
+				Func().Parens(jen.Id("e").Op("*").Id(envName)).Id(fmt.Sprintf("ExecuteWorkflow%s", method.GoName)).ParamsFunc(func(g *jen.Group) {
+				g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent)))
+			}).BlockFunc(func(g *jen.Group) {
+				g.Add(jen.Id("e").Dot("env").Dot("ExecuteWorkflow").Call(jen.Lit(methName), jen.Id("req")))
+			}).Line().Line()
+
+			testEnv.Comment(fmt.Sprintf("%sResult returns the result of the %s workflow executed in the test environment", method.GoName, method.GoName)).Line().
+				Func().Parens(jen.Id("e").Op("*").Id(envName)).Id(fmt.Sprintf("%sResult", method.GoName)).Params().ParamsFunc(func(g *jen.Group) {
+				g.Add(jen.Op("*").Id(gf.QualifiedGoIdent(method.Output.GoIdent)))
+				g.Add(jen.Error())
+			}).BlockFunc(func(g *jen.Group) {
+				g.Add(jen.If(jen.Op("!").Id("e").Dot("env").Dot("IsWorkflowCompleted").Call()).Block(
+					jen.Return(jen.Nil(), jen.Id(getFmtObject(gf, "Errorf")).Call(jen.Lit(fmt.Sprintf("workflow %s is not completed", methName)))),
+				))
+
+				g.Add(jen.Var().Id("resp").Op("*").Id(gf.QualifiedGoIdent(method.Output.GoIdent)))
+
+				g.Add(jen.Id("err").Op(":=").Id("e").Dot("env").Dot("GetWorkflowResult").Call(jen.Op("&").Id("resp")))
+
+				g.Add(IfErrNilDouble)
+
+				g.Add(jen.Return(jen.Id("resp"), jen.Nil()))
+			}).Line().Line()
+		case MethodTypeSignal:
+			testEnv.Add(testEnvSignal(gf, envName, method, methName))
+		case MethodTypeQuery:
+			testEnv.Add(testEnvQuery(gf, envName, method, methName))
+		}
+	}
+
+	shared, err := sharedMethods(service, config)
+	if err != nil {
+		return err
+	}
+
+	for _, method := range shared {
+		t, err := getMethodType(method)
+		if err != nil {
+			return err
+		}
+
+		methName, err := getMethodRegisteredName(method)
+		if err != nil {
+			return err
+		}
+
+		switch t {
+		case MethodTypeSignal:
+			testEnv.Add(testEnvSignal(gf, envName, method, methName))
+		case MethodTypeQuery:
+			testEnv.Add(testEnvQuery(gf, envName, method, methName))
+		}
+	}
+
+	buf := bytes.NewBufferString("")
+	if err := testEnv.Render(buf); err != nil {
+		return err
+	}
+
+	gf.P(buf.String())
+
+	return nil
+}
+
+// testEnvSignal generates the method of the test environment sending a signal
+func testEnvSignal(gf *protogen.GeneratedFile, envName string, method *protogen.Method, methName string) *jen.Statement {
+	return jen.Comment(fmt.Sprintf("Signal%s sends the %s signal to the workflow executed in the test environment", method.GoName, method.GoName)).Line().
+		Func().Parens(jen.Id("e").Op("*").Id(envName)).Id(fmt.Sprintf("Signal%s", method.GoName)).ParamsFunc(func(g *jen.Group) {
+		g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent)))
+	}).BlockFunc(func(g *jen.Group) {
+		g.Add(jen.Id("e").Dot("env").Dot("SignalWorkflow").Call(jen.Lit(methName), jen.Id("req")))
+	}).Line().Line().
+		Comment(fmt.Sprintf("Signal%sDelayed sends the %s signal to the workflow executed in the test environment after a delay", method.GoName, method.GoName)).Line().
+		Comment("It must be called before the workflow is executed").Line().
+		Func().Parens(jen.Id("e").Op("*").Id(envName)).Id(fmt.Sprintf("Signal%sDelayed", method.GoName)).ParamsFunc(func(g *jen.Group) {
+		g.Add(jen.Id("delay").Id(getTimeObject(gf, "Duration")))
+		g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent)))
+	}).BlockFunc(func(g *jen.Group) {
+		g.Add(jen.Id("e").Dot("env").Dot("RegisterDelayedCallback").Call(
+			jen.Func().Params().Block(
+				jen.Id("e").Dot("env").Dot("SignalWorkflow").Call(jen.Lit(methName), jen.Id("req")),
+			),
+			jen.Id("delay"),
+		))
+	}).Line().Line()
+}
+
+// testEnvQuery generates the method of the test environment sending a query
+func testEnvQuery(gf *protogen.GeneratedFile, envName string, method *protogen.Method, methName string) *jen.Statement {
+	return jen.Comment(fmt.Sprintf("Query%s queries the workflow executed in the test environment with %s", method.GoName, method.GoName)).Line().
+		Func().Parens(jen.Id("e").Op("*").Id(envName)).Id(fmt.Sprintf("Query%s", method.GoName)).ParamsFunc(func(g *jen.Group) {
+		g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent)))
+	}).ParamsFunc(func(g *jen.Group) {
+		g.Add(jen.Op("*").Id(gf.QualifiedGoIdent(method.Output.GoIdent)))
+		g.Add(jen.Error())
+	}).BlockFunc(func(g *jen.Group) {
+		g.Add(jen.Id("value").Op(",").Err().Op(":=").Id("e").Dot("env").Dot("QueryWorkflow").Call(jen.Lit(methName), jen.Id("req")))
+
+		g.Add(IfErrNilDouble)
+
+		g.Add(jen.Var().Id("resp").Op("*").Id(gf.QualifiedGoIdent(method.Output.GoIdent)))
+
+		g.Add(jen.Id("err").Op("=").Id("value").Dot("Get").Call(jen.Op("&").Id("resp")))
+
+		g.Add(IfErrNilDouble)
+
+		g.Add(jen.Return(jen.Id("resp"), jen.Nil()))
+	}).Line().Line()
+}
+
+// sharedMethods returns the signals and queries defined by other services
+// that the workflows of the service use, skipping the ones whose Go name is
+// already taken by a method of the service
+func sharedMethods(service *protogen.Service, config *Config) ([]*protogen.Method, error) {
+	taken := make(map[string]bool)
+	for _, method := range service.Methods {
+		taken[method.GoName] = true
+	}
+
+	shared := make([]*protogen.Method, 0)
+	for _, method := range service.Methods {
+		t, err := getMethodType(method)
+		if err != nil {
+			return nil, err
+		}
+
+		if t != MethodTypeWorkflow {
+			continue
+		}
+
+		workflowOptions := getWorkflowOptions(method)
+		if workflowOptions == nil {
+			workflowOptions = getDefaultWorkflowOptions(service)
+		}
+
+		for _, refs := range []struct {
+			names []string
+			t     MethodType
+		}{
+			{workflowOptions.GetSignals(), MethodTypeSignal},
+			{workflowOptions.GetQueries(), MethodTypeQuery},
+		} {
+			for _, name := range refs.names {
+				meth, err := config.registry.findMethod(service, name, refs.t)
+				if err != nil {
+					return nil, fmt.Errorf("invalid workflow %s: %w", method.GoName, err)
+				}
+
+				if taken[meth.GoName] {
+					continue
+				}
+				taken[meth.GoName] = true
+				shared = append(shared, meth)
+			}
+		}
+	}
+
+	return shared, nil
+}
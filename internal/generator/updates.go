@@ -0,0 +1,248 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func getUpdateHandleName(service *protogen.Service, method *protogen.Method) string {
+	return fmt.Sprintf("%s%sUpdateHandle", service.GoName, method.GoName)
+}
+
+func ServiceUpdates(gf *protogen.GeneratedFile, service *protogen.Service, config *Config) error {
+	clientName := getClientName(service)
+
+	updates := jen.Null()
+
+	for _, method := range service.Methods {
+		t, err := getMethodType(method)
+		if err != nil {
+			return err
+		}
+
+		if t != MethodTypeUpdate {
+			continue
+		}
+
+		updateName, err := getMethodRegisteredName(method)
+		if err != nil {
+			return err
+		}
+
+		handleName := getUpdateHandleName(service, method)
+
+		hook, err := getClientHook(gf, service, config, fmt.Sprintf("Update%s", method.GoName))
+		if err != nil {
+			return err
+		}
+
+		/*
+			Update handle struct
+
+			type SomeServiceSomeUpdateUpdateHandle struct {
+				handle client.WorkflowUpdateHandle
+			}
+
+			It implements client.WorkflowUpdateHandle and exposes a typed Result method
+		*/
+		updates.Comment(fmt.Sprintf("%s is a struct that wraps the handle of a %s update", handleName, method.GoName)).Line().
+			Type().Id(handleName).StructFunc(func(g *jen.Group) {
+			g.Add(jen.Id("handle").Id(getTemporalClientObject(gf, "WorkflowUpdateHandle")))
+		}).Line()
+
+		updates.Comment("WorkflowID returns the ID of the updated workflow").Line().
+			Func().Parens(jen.Id("h").Op("*").Id(handleName)).Id("WorkflowID").Parens(jen.Null()).String().
+			BlockFunc(func(g *jen.Group) {
+				g.Add(jen.Return(jen.Id("h").Dot("handle").Dot("WorkflowID").Parens(jen.Null())))
+			}).Line()
+
+		updates.Comment("RunID returns the run ID of the updated workflow").Line().
+			Func().Parens(jen.Id("h").Op("*").Id(handleName)).Id("RunID").Parens(jen.Null()).String().
+			BlockFunc(func(g *jen.Group) {
+				g.Add(jen.Return(jen.Id("h").Dot("handle").Dot("RunID").Parens(jen.Null())))
+			}).Line()
+
+		updates.Comment("UpdateID returns the ID of the update").Line().
+			Func().Parens(jen.Id("h").Op("*").Id(handleName)).Id("UpdateID").Parens(jen.Null()).String().
+			BlockFunc(func(g *jen.Group) {
+				g.Add(jen.Return(jen.Id("h").Dot("handle").Dot("UpdateID").Parens(jen.Null())))
+			}).Line()
+
+		updates.Comment("Result blocks until the update completes and returns its result with its native type").Line().
+			Func().Parens(jen.Id("h").Op("*").Id(handleName)).Id("Result").ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Id("ctx").Id(getContext(gf)))
+		}).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Op("*").Id(gf.QualifiedGoIdent(method.Output.GoIdent)))
+			g.Add(jen.Error())
+		}).BlockFunc(func(g *jen.Group) {
+			g.Add(jen.Var().Id("resp").Op("*").Id(gf.QualifiedGoIdent(method.Output.GoIdent)))
+
+			g.Add(jen.Id("err").Op(":=").Id("h").Dot("handle").Dot("Get").CallFunc(func(g *jen.Group) {
+				g.Add(jen.Id("ctx"))
+				g.Add(jen.Op("&").Id("resp"))
+			}))
+
+			g.Add(IfErrNilDouble)
+
+			g.Add(jen.ReturnFunc(func(g *jen.Group) {
+				g.Add(jen.Id("resp"))
+				g.Add(jen.Nil())
+			}))
+		}).Line()
+
+		updates.Comment("Get gets the result of the update with pointers -- discouraged to use but required to implement client.WorkflowUpdateHandle").Line().
+			Func().Parens(jen.Id("h").Op("*").Id(handleName)).Id("Get").ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Id("ctx").Id(getContext(gf)))
+			g.Add(jen.Id("valuePtr").InterfaceFunc(func(g *jen.Group) {}))
+		}).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Error())
+		}).BlockFunc(func(g *jen.Group) {
+			g.Add(jen.Return(jen.Id("h").Dot("handle").Dot("Get").CallFunc(func(g *jen.Group) {
+				g.Add(jen.Id("ctx"))
+				g.Add(jen.Id("valuePtr"))
+			})))
+		}).Line()
+
+		updates.Comment(fmt.Sprintf("Update%s sends the %s update to a workflow and waits for its result", method.GoName, method.GoName)).Line().
+			Func().Parens(jen.Id("c").Op("*").Id(clientName)).Id(fmt.Sprintf("Update%s", method.GoName)).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Id("ctx").Id(getContext(gf)))
+			g.Add(jen.Id("workflowID").String())
+			g.Add(jen.Id("runID").String())
+			g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent)))
+		}).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Op("*").Id(gf.QualifiedGoIdent(method.Output.GoIdent)))
+			g.Add(jen.Error())
+		}).BlockFunc(func(g *jen.Group) {
+			g.Add(jen.Id("stage").Op(":=").Id(getTemporalClientObject(gf, "WorkflowUpdateStageCompleted")))
+			for _, s := range hook.chain(jen.Id("handle"), jen.Err()) {
+				g.Add(s)
+			}
+
+			g.Add(IfErrNilDouble)
+
+			g.Add(jen.Var().Id("resp").Op("*").Id(gf.QualifiedGoIdent(method.Output.GoIdent)))
+
+			g.Add(jen.Id("err").Op("=").Id("handle").Dot("Get").CallFunc(func(g *jen.Group) {
+				g.Add(jen.Id("ctx"))
+				g.Add(jen.Op("&").Id("resp"))
+			}))
+
+			g.Add(IfErrNilDouble)
+
+			g.Add(jen.ReturnFunc(func(g *jen.Group) {
+				g.Add(jen.Id("resp"))
+				g.Add(jen.Nil())
+			}))
+		}).Line()
+
+		updates.Comment(fmt.Sprintf("Update%sAsync sends the %s update to a workflow and returns a handle to it once it has been accepted", method.GoName, method.GoName)).Line().
+			Func().Parens(jen.Id("c").Op("*").Id(clientName)).Id(fmt.Sprintf("Update%sAsync", method.GoName)).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Id("ctx").Id(getContext(gf)))
+			g.Add(jen.Id("workflowID").String())
+			g.Add(jen.Id("runID").String())
+			g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent)))
+		}).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Op("*").Id(handleName))
+			g.Add(jen.Error())
+		}).BlockFunc(func(g *jen.Group) {
+			g.Add(jen.Id("stage").Op(":=").Id(getTemporalClientObject(gf, "WorkflowUpdateStageAccepted")))
+			for _, s := range hook.chain(jen.Id("handle"), jen.Err()) {
+				g.Add(s)
+			}
+
+			g.Add(IfErrNilDouble)
+
+			g.Add(jen.ReturnFunc(func(g *jen.Group) {
+				g.Add(jen.Op("&").Id(handleName).Values(jen.Dict{
+					jen.Id("handle"): jen.Id("handle"),
+				}))
+				g.Add(jen.Nil())
+			}))
+		}).Line()
+
+		impl, err := updateImpl(gf, service, hook, config)
+		if err != nil {
+			return err
+		}
+
+		updates.Add(impl)
+
+		updates.Comment(fmt.Sprintf("HandleUpdate%s sets up the %s update handler, returns an error if it failed", method.GoName, method.GoName)).Line().
+			Comment("The validator is optional and can be left nil, it must not alter the workflow state").Line().
+			Func().Id(fmt.Sprintf("HandleUpdate%s", method.GoName)).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Id("ctx").Id(getTemporalWorkflowObject(gf, "Context")))
+			g.Add(jen.Id("updateFunc").Func().ParamsFunc(func(g *jen.Group) {
+				g.Add(jen.Id("ctx").Id(getTemporalWorkflowObject(gf, "Context")))
+				g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent)))
+			}).ParamsFunc(func(g *jen.Group) {
+				g.Add(jen.Op("*").Id(gf.QualifiedGoIdent(method.Output.GoIdent)))
+				g.Add(jen.Error())
+			}))
+			g.Add(jen.Id("validatorFunc").Func().ParamsFunc(func(g *jen.Group) {
+				g.Add(jen.Id("ctx").Id(getTemporalWorkflowObject(gf, "Context")))
+				g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent)))
+			}).Error())
+		}).ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Error())
+		}).BlockFunc(func(g *jen.Group) {
+			g.Add(jen.Id("opts").Op(":=").Id(getTemporalWorkflowObject(gf, "UpdateHandlerOptions")).Block())
+			// a nil func wrapped in an interface{} is not nil, so only set it when we have one
+			g.Add(jen.If(jen.Id("validatorFunc").Op("!=").Nil()).Block(
+				jen.Id("opts").Dot("Validator").Op("=").Id("validatorFunc"),
+			))
+
+			g.Add(jen.Return().Id(getTemporalWorkflowObject(gf, "SetUpdateHandlerWithOptions")).CallFunc(func(g *jen.Group) {
+				g.Add(jen.Id("ctx"))
+				g.Add(jen.Lit(updateName))
+				g.Add(jen.Id("updateFunc"))
+				g.Add(jen.Id("opts"))
+			}))
+		}).Line()
+	}
+
+	buf := bytes.NewBufferString("")
+	if err := updates.Render(buf); err != nil {
+		return err
+	}
+
+	gf.P(buf.String())
+
+	return nil
+}
+
+// updateImpl returns the unexported method of the client sending an update
+// once the client interceptors ran
+func updateImpl(gf *protogen.GeneratedFile, service *protogen.Service, hook *clientHook, config *Config) (*jen.Statement, error) {
+	updateName, err := getMethodRegisteredName(hook.rpc)
+	if err != nil {
+		return nil, err
+	}
+
+	params, _, results := hook.signature()
+
+	return jen.Comment(fmt.Sprintf("%s sends the update once the client interceptors ran", hook.getImplName())).Line().
+		Func().Parens(jen.Id("c").Op("*").Id(getClientName(service))).Id(hook.getImplName()).Params(params...).Params(results...).BlockFunc(func(g *jen.Group) {
+		if validatesBeforeSending(hook.rpc, config) {
+			g.Add(validateRequest(service, updateName, "req", jen.Return(jen.Nil(), jen.Err())))
+		}
+
+		g.Add(jen.Return(jen.Id("c").Dot("client").Dot("UpdateWorkflow").Call(
+			jen.Id("ctx"),
+			updateWorkflowOptions(gf, jen.Id("workflowID"), jen.Id("runID"), updateName, jen.Id("stage")),
+		)))
+	}).Line(), nil
+}
+
+// updateWorkflowOptions returns the client.UpdateWorkflowOptions literal used to send an update
+func updateWorkflowOptions(gf *protogen.GeneratedFile, workflowID *jen.Statement, runID *jen.Statement, updateName string, stage *jen.Statement) *jen.Statement {
+	return jen.Id(getTemporalClientObject(gf, "UpdateWorkflowOptions")).Values(jen.DictFunc(func(d jen.Dict) {
+		d[jen.Id("WorkflowID")] = workflowID
+		d[jen.Id("RunID")] = runID
+		d[jen.Id("UpdateName")] = jen.Lit(updateName)
+		d[jen.Id("Args")] = jen.Index().Interface().Values(jen.Id("req"))
+		d[jen.Id("WaitForStage")] = stage
+	}))
+}
@@ -0,0 +1,211 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	temporalv1 "github.com/thomas-maurice/protoc-gen-go-tmprl/gen/temporal/v1"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+)
+
+func getValidationErrorName(service *protogen.Service) string {
+	return fmt.Sprintf("%sValidationError", service.GoName)
+}
+
+func getValidateRequestName(service *protogen.Service) string {
+	return fmt.Sprintf("validate%sRequest", service.GoName)
+}
+
+// validatesOnReceipt returns true if the worker validates the requests of a
+// workflow or an activity again before running it, the option of the method
+// taking precedence over the service defaults and the plugin option
+func validatesOnReceipt(service *protogen.Service, method *protogen.Method, config *Config) bool {
+	switch t, _ := getMethodType(method); t {
+	case MethodTypeActivity:
+		if opts := getActivityOptions(method); opts != nil && opts.ValidateOnReceipt != nil {
+			return opts.GetValidateOnReceipt()
+		}
+		if opts := getDefaultActivityOptions(service); opts != nil && opts.ValidateOnReceipt != nil {
+			return opts.GetValidateOnReceipt()
+		}
+	case MethodTypeWorkflow:
+		if opts := getWorkflowOptions(method); opts != nil && opts.ValidateOnReceipt != nil {
+			return opts.GetValidateOnReceipt()
+		}
+		if opts := getDefaultWorkflowOptions(service); opts != nil && opts.ValidateOnReceipt != nil {
+			return opts.GetValidateOnReceipt()
+		}
+	default:
+		return false
+	}
+
+	return config.ValidationOnReceipt
+}
+
+// validatesBeforeSending returns true if the requests of a method are validated
+// before they are sent, the option of the method taking precedence over the
+// defaults of its service and the plugin option
+func validatesBeforeSending(method *protogen.Method, config *Config) bool {
+	switch t, _ := getMethodType(method); t {
+	case MethodTypeActivity:
+		if opts := getActivityOptions(method); opts != nil && opts.Validate != nil {
+			return opts.GetValidate()
+		}
+		if opts := getDefaultActivityOptions(method.Parent); opts != nil && opts.Validate != nil {
+			return opts.GetValidate()
+		}
+	case MethodTypeWorkflow:
+		if opts := getWorkflowOptions(method); opts != nil && opts.Validate != nil {
+			return opts.GetValidate()
+		}
+		if opts := getDefaultWorkflowOptions(method.Parent); opts != nil && opts.Validate != nil {
+			return opts.GetValidate()
+		}
+	case MethodTypeSignal:
+		if opts, _ := proto.GetExtension(method.Desc.Options(), temporalv1.E_Signal).(*temporalv1.SignalOptions); opts != nil && opts.Validate != nil {
+			return opts.GetValidate()
+		}
+	case MethodTypeUpdate:
+		if opts, _ := proto.GetExtension(method.Desc.Options(), temporalv1.E_Update).(*temporalv1.UpdateOptions); opts != nil && opts.Validate != nil {
+			return opts.GetValidate()
+		}
+	default:
+		return false
+	}
+
+	return config.Validation
+}
+
+// hasValidation returns true if the generated code of a service validates
+// requests anywhere, including the signals and updates of other services its
+// workflows reference
+func hasValidation(service *protogen.Service, config *Config) bool {
+	for _, method := range service.Methods {
+		if validatesBeforeSending(method, config) || validatesOnReceipt(service, method, config) {
+			return true
+		}
+
+		if t, _ := getMethodType(method); t != MethodTypeWorkflow {
+			continue
+		}
+
+		// the same options as the workflow objects
+		opts := getWorkflowOptions(method)
+		if opts == nil {
+			opts = getDefaultWorkflowOptions(service)
+		}
+		for _, name := range opts.GetSignals() {
+			if ref, err := config.registry.findMethod(service, name, MethodTypeSignal); err == nil && validatesBeforeSending(ref, config) {
+				return true
+			}
+		}
+		for _, name := range opts.GetUpdates() {
+			if ref, err := config.registry.findMethod(service, name, MethodTypeUpdate); err == nil && validatesBeforeSending(ref, config) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// validateRequest returns the statement validating a request before it is sent
+// under the given name, running onError when it is invalid
+func validateRequest(service *protogen.Service, name string, req string, onError ...jen.Code) *jen.Statement {
+	return jen.If(
+		jen.Err().Op(":=").Id(getValidateRequestName(service)).Call(jen.Lit(name), jen.Id(req)),
+		jen.Err().Op("!=").Nil(),
+	).Block(onError...)
+}
+
+// validateRequestFuture returns the statement validating a request before an
+// activity is scheduled, returning a future failed with the validation error
+func validateRequestFuture(gf *protogen.GeneratedFile, service *protogen.Service, name string, req string) *jen.Statement {
+	return validateRequest(service, name, req,
+		jen.List(jen.Id("future"), jen.Id("settable")).Op(":=").Id(getTemporalWorkflowObject(gf, "NewFuture")).Call(jen.Id("ctx")),
+		jen.Id("settable").Dot("SetError").Call(jen.Err()),
+		jen.Return(jen.Id("future")),
+	)
+}
+
+// registeredFunc returns the function of `svc` registered for a workflow or an
+// activity, wrapping the implementation to validate the request first when it
+// is validated on receipt
+func registeredFunc(gf *protogen.GeneratedFile, service *protogen.Service, method *protogen.Method, name string, ctx string, config *Config) *jen.Statement {
+	if !validatesOnReceipt(service, method, config) {
+		return jen.Id("svc").Dot(method.GoName)
+	}
+
+	return jen.Func().Params(
+		jen.Id("ctx").Id(ctx),
+		jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent)),
+	).Params(jen.Op("*").Id(gf.QualifiedGoIdent(method.Output.GoIdent)), jen.Error()).Block(
+		validateRequest(service, name, "req",
+			jen.Return(jen.Nil(), jen.Id(getTemporalObject(gf, "NewNonRetryableApplicationError")).Call(
+				jen.Err().Dot("Error").Call(),
+				jen.Id(getValidationErrorName(service)+"Type"),
+				jen.Err(),
+			)),
+		),
+		jen.Return(jen.Id("svc").Dot(method.GoName).Call(jen.Id("ctx"), jen.Id("req"))),
+	)
+}
+
+// ServiceValidation generates the validation error of a service and the helper
+// calling the Validate method of its requests
+func ServiceValidation(gf *protogen.GeneratedFile, service *protogen.Service, config *Config) error {
+	if !hasValidation(service, config) {
+		return nil
+	}
+
+	errorName := getValidationErrorName(service)
+	errorType := errorName + "Type"
+
+	validation := jen.Null()
+
+	validation.Comment(fmt.Sprintf("%s is the type of the application errors returned by the workers", errorType)).Line().
+		Comment(fmt.Sprintf("of the %s service when a request fails its validation, they are not retried", service.GoName)).Line().
+		Const().Id(errorType).Op("=").Lit(fmt.Sprintf("%s.ValidationError", service.Desc.FullName())).Line().Line()
+
+	validation.Comment(fmt.Sprintf("%s is returned when a request of the %s service fails its validation", errorName, service.GoName)).Line().
+		Type().Id(errorName).Struct(
+		jen.Comment("Name is the registered name of the workflow, activity or signal"),
+		jen.Id("Name").String(),
+		jen.Comment("Err is the error returned by the Validate method of the request"),
+		jen.Id("Err").Error(),
+	).Line().Line()
+
+	validation.Func().Parens(jen.Id("e").Op("*").Id(errorName)).Id("Error").Params().String().Block(
+		jen.Return(jen.Id(getFmtObject(gf, "Sprintf")).Call(jen.Lit("invalid request for %s: %v"), jen.Id("e").Dot("Name"), jen.Id("e").Dot("Err"))),
+	).Line().Line()
+
+	validation.Func().Parens(jen.Id("e").Op("*").Id(errorName)).Id("Unwrap").Params().Error().Block(
+		jen.Return(jen.Id("e").Dot("Err")),
+	).Line().Line()
+
+	validation.Comment(fmt.Sprintf("%s calls the Validate method of a request if it implements one", getValidateRequestName(service))).Line().
+		Func().Id(getValidateRequestName(service)).Params(jen.Id("name").String(), jen.Id("req").Interface()).Error().BlockFunc(func(g *jen.Group) {
+		g.Add(jen.List(jen.Id("v"), jen.Id("ok")).Op(":=").Id("req").Assert(jen.Interface(jen.Id("Validate").Params().Error())))
+		g.Add(jen.If(jen.Op("!").Id("ok")).Block(jen.Return(jen.Nil())))
+		g.Line()
+		g.Add(jen.If(jen.Err().Op(":=").Id("v").Dot("Validate").Call(), jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Op("&").Id(errorName).Values(jen.Dict{
+				jen.Id("Name"): jen.Id("name"),
+				jen.Id("Err"):  jen.Err(),
+			})),
+		))
+		g.Line()
+		g.Add(jen.Return(jen.Nil()))
+	}).Line()
+
+	buf := bytes.NewBufferString("")
+	if err := validation.Render(buf); err != nil {
+		return err
+	}
+
+	gf.P(buf.String())
+
+	return nil
+}
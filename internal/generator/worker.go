@@ -8,7 +8,7 @@ import (
 	"google.golang.org/protobuf/compiler/protogen"
 )
 
-func Worker(gf *protogen.GeneratedFile, service *protogen.Service) error {
+func Worker(gf *protogen.GeneratedFile, service *protogen.Service, config *Config) error {
 	workerName := fmt.Sprintf("%sWorker", service.GoName)
 
 	worker := jen.Comment(fmt.Sprintf("%s: Worker for the %s service", workerName, service.GoName)).Line().
@@ -17,6 +17,10 @@ func Worker(gf *protogen.GeneratedFile, service *protogen.Service) error {
 			g.Add(jen.Id("client").Id(getTemporalClientObject(gf, "Client")))
 			g.Add(jen.Id("worker").Id(getTemporalWorkerObject(gf, "Worker")))
 			g.Add(jen.Id("svc").Id(getSvcName(service)))
+			if config.ProtoConverter != "" {
+				// only the clients dialed by the constructors are closed by Close
+				g.Add(jen.Id("dialed").Bool())
+			}
 		}).Line().Line().
 		// New worker func
 		Comment(fmt.Sprintf("New%s: Returns a new instance of the worker.", workerName)).Line().
@@ -72,51 +76,7 @@ func Worker(gf *protogen.GeneratedFile, service *protogen.Service) error {
 		// Register func, this will register activities and workflows in the client
 		Comment("Register registers the worker and its activities/workflows in temporal").Line().
 		Func().Parens(jen.Id("w").Op("*").Id(workerName)).Id("Register").ParamsFunc(func(g *jen.Group) {}).BlockFunc(func(g *jen.Group) {
-		for _, m := range service.Methods {
-			switch t, _ := getMethodType(m); t {
-			case MethodTypeActivity:
-				/*
-					w.client.RegisterActivityWithOptions(w.svc.Activity, activity.RegisterOptions{
-						Name: "example.v1.Activity",
-					})
-				*/
-				name, err := getMethodRegisteredName(m)
-				if err != nil {
-					panic(err)
-				}
-				g.Add(
-					jen.Comment(fmt.Sprintf("Registers activity %s", m.GoName)).Line().
-						Id("w").Dot("worker").Dot("RegisterActivityWithOptions").Parens(
-						jen.Id("w").Dot("svc").Dot(m.GoName).Op(",").Id(getTemporalActivityObject(gf, "RegisterOptions")).Block(
-							jen.Id("Name").Op(":").Lit(name).Op(","),
-						),
-					),
-				)
-
-			case MethodTypeWorkflow:
-				/*
-					w.client.RegisterActivityWithOptions(w.svc.Workflow, workflow.RegisterOptions{
-						Name: "example.v1.Workflow",
-					})
-				*/
-				name, err := getMethodRegisteredName(m)
-				if err != nil {
-					panic(err)
-				}
-				g.Add(
-					jen.Comment(fmt.Sprintf("Registers workflow %s", m.GoName)).Line().
-						Id("w").Dot("worker").Dot("RegisterWorkflowWithOptions").Parens(
-						jen.Id("w").Dot("svc").Dot(m.GoName).Op(",").Id(getTemporalWorkflowObject(gf, "RegisterOptions")).Block(
-							jen.Id("Name").Op(":").Lit(name).Op(","),
-						),
-					),
-				)
-			}
-		}
-
-		jen.ReturnFunc(func(g *jen.Group) {
-			g.Add(jen.Nil())
-		})
+		g.Add(jen.Id(getRegisterServiceName(service)).Call(jen.Id("w").Dot("worker"), jen.Id("w").Dot("svc")))
 	}).Line().
 		/*
 			// Start func like so
@@ -167,7 +127,8 @@ func Worker(gf *protogen.GeneratedFile, service *protogen.Service) error {
 				jen.Null(),
 			),
 		)
-	})
+	}).Line().Line().
+		Add(registerService(gf, service, config))
 
 	buf := bytes.NewBufferString("")
 
@@ -180,3 +141,59 @@ func Worker(gf *protogen.GeneratedFile, service *protogen.Service) error {
 
 	return nil
 }
+
+// registerService generates the function registering the workflows and the
+// activities of a service in a worker or a test environment
+func registerService(gf *protogen.GeneratedFile, service *protogen.Service, config *Config) *jen.Statement {
+	registerName := getRegisterServiceName(service)
+
+	return jen.Comment(fmt.Sprintf("%s registers the workflows and activities of `svc` in a worker or a test environment", registerName)).Line().
+		Func().Id(registerName).
+		ParamsFunc(func(g *jen.Group) {
+			g.Add(jen.Id("r").Id(getTemporalWorkerObject(gf, "Registry")))
+			g.Add(jen.Id("svc").Id(getSvcName(service)))
+		}).
+		BlockFunc(func(g *jen.Group) {
+			for _, m := range service.Methods {
+				switch t, _ := getMethodType(m); t {
+				case MethodTypeActivity:
+					/*
+						r.RegisterActivityWithOptions(svc.Activity, activity.RegisterOptions{
+							Name: "example.v1.Activity",
+						})
+					*/
+					name, err := getMethodRegisteredName(m)
+					if err != nil {
+						panic(err)
+					}
+					g.Add(
+						jen.Comment(fmt.Sprintf("Registers activity %s", m.GoName)).Line().
+							Id("r").Dot("RegisterActivityWithOptions").Parens(
+							registeredFunc(gf, service, m, name, getContext(gf), config).Op(",").Id(getTemporalActivityObject(gf, "RegisterOptions")).Block(
+								jen.Id("Name").Op(":").Lit(name).Op(","),
+							),
+						),
+					)
+
+				case MethodTypeWorkflow:
+					/*
+						r.RegisterWorkflowWithOptions(svc.Workflow, workflow.RegisterOptions{
+							Name: "example.v1.Workflow",
+						})
+					*/
+					name, err := getMethodRegisteredName(m)
+					if err != nil {
+						panic(err)
+					}
+					g.Add(
+						jen.Comment(fmt.Sprintf("Registers workflow %s", m.GoName)).Line().
+							Id("r").Dot("RegisterWorkflowWithOptions").Parens(
+							registeredFunc(gf, service, m, name, getTemporalWorkflowObject(gf, "Context"), config).Op(",").Id(getTemporalWorkflowObject(gf, "RegisterOptions")).Block(
+								jen.Id("Name").Op(":").Lit(name).Op(","),
+							),
+						),
+					)
+				}
+			}
+		}).Line()
+}
@@ -18,28 +18,9 @@ func getChildWorkflowObjectName(service *protogen.Service, method *protogen.Meth
 	return fmt.Sprintf("Child%s%sExecution", service.GoName, method.GoName)
 }
 
-func WorkflowObjects(gf *protogen.GeneratedFile, service *protogen.Service) error {
+func WorkflowObjects(gf *protogen.GeneratedFile, service *protogen.Service, config *Config) error {
 	clientName := getClientName(service)
 
-	// build a map of the queries and signals so we can do a lookup when a workflow
-	// can recieve them
-	signalsMap := make(map[string]*protogen.Method)
-	queriesMap := make(map[string]*protogen.Method)
-
-	for _, method := range service.Methods {
-		t, err := getMethodType(method)
-		if err != nil {
-			return err
-		}
-
-		switch t {
-		case MethodTypeQuery:
-			queriesMap[method.GoName] = method
-		case MethodTypeSignal:
-			signalsMap[method.GoName] = method
-		}
-	}
-
 	workflowObjects := jen.Null().Line()
 
 	for _, method := range service.Methods {
@@ -55,6 +36,21 @@ func WorkflowObjects(gf *protogen.GeneratedFile, service *protogen.Service) erro
 
 		switch t {
 		case MethodTypeWorkflow:
+			if err := config.registry.checkMethodNames(service, method, workflowOptions.GetSignals(), MethodTypeSignal); err != nil {
+				return err
+			}
+			if err := config.registry.checkMethodNames(service, method, workflowOptions.GetQueries(), MethodTypeQuery); err != nil {
+				return err
+			}
+			if err := config.registry.checkMethodNames(service, method, workflowOptions.GetUpdates(), MethodTypeUpdate); err != nil {
+				return err
+			}
+
+			// the SDK refuses to start a workflow with both policies
+			if getMergedWorkflowOptions(service, method).IdReusePolicy == temporalv1.WorkflowIdReusePolicy_WORKFLOW_ID_REUSE_POLICY_TERMINATE_IF_RUNNING {
+				return fmt.Errorf("the TERMINATE_IF_RUNNING ID reuse policy of workflow %s cannot be used with the USE_EXISTING ID conflict policy of StartOrAttach%s, use the TERMINATE_EXISTING ID conflict policy instead", method.Desc.FullName(), method.GoName)
+			}
+
 			/*
 				Workflow result structs
 
@@ -79,6 +75,7 @@ func WorkflowObjects(gf *protogen.GeneratedFile, service *protogen.Service) erro
 			wfChildObjName := getChildWorkflowObjectName(service, method)
 			workflowObjects.Comment(fmt.Sprintf("%s is a struct that wraps a workflow", wfObjName)).Line().
 				Type().Id(wfObjName).StructFunc(func(g *jen.Group) {
+				g.Add(jen.Id("c").Op("*").Id(clientName))
 				g.Add(jen.Id("client").Id(getTemporalClientObject(gf, "Client")))
 				g.Add(jen.Id("future").Id(getTemporalClientObject(gf, "WorkflowRun")))
 				g.Add(jen.Id("workflowId").String())
@@ -103,6 +100,7 @@ func WorkflowObjects(gf *protogen.GeneratedFile, service *protogen.Service) erro
 
 					g.Add(jen.ReturnFunc(func(g *jen.Group) {
 						g.Add(jen.Op("&").Id(wfObjName).BlockFunc(func(g *jen.Group) {
+							g.Add(jen.Id("c").Op(":").Id("c").Op(","))
 							g.Add(jen.Id("client").Op(":").Id("c").Dot("client").Op(","))
 							g.Add(jen.Id("future").Op(":").Id("future").Op(","))
 							g.Add(jen.Id("workflowId").Op(":").Id("workflowId").Op(","))
@@ -123,12 +121,51 @@ func WorkflowObjects(gf *protogen.GeneratedFile, service *protogen.Service) erro
 						g.Add(jen.Op("&").Id(wfObjName).BlockFunc(func(g *jen.Group) {
 							g.Add(jen.Id("workflowId").Op(":").Id("future").Dot("GetID").Call(jen.Null()).Op(","))
 							g.Add(jen.Id("runId").Op(":").Id("future").Dot("GetRunID").Call(jen.Null()).Op(","))
+							g.Add(jen.Id("c").Op(":").Id("c").Op(","))
 							g.Add(jen.Id("client").Op(":").Id("c").Dot("client").Op(","))
 							g.Add(jen.Id("future").Op(":").Id("future").Op(","))
 						}))
 					}))
 				}).Line().Line()
 
+			// Starts the workflow or attaches to the running one
+			hasIDTemplate := getWorkflowOptions(method).GetIdTemplate() != ""
+			startOrAttachDoc := "The USE_EXISTING ID conflict policy is used unless another one is set in `options`"
+			if !hasIDTemplate {
+				startOrAttachDoc += ", which must set the workflow ID"
+			}
+			workflowObjects.Comment(fmt.Sprintf("StartOrAttach%s starts the workflow, or attaches to the running execution if the workflow ID is already in use", method.GoName)).Line().
+				Comment(startOrAttachDoc).Line().
+				Func().Parens(jen.Id("c").Op("*").Id(clientName)).Id(fmt.Sprintf("StartOrAttach%s", method.GoName)).ParamsFunc(func(g *jen.Group) {
+				g.Add(jen.Id("ctx").Id(getContext(gf)))
+				g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(method.Input.GoIdent)))
+				g.Add(jen.Id("options").Op("...").Id(getTemporalClientObject(gf, "StartWorkflowOptions")))
+			}).ParamsFunc(func(g *jen.Group) {
+				g.Add(jen.Op("*").Id(wfObjName))
+				g.Add(jen.Error())
+			}).
+				BlockFunc(func(g *jen.Group) {
+					g.Add(jen.Id("wOptions").Op(":=").Id(getTemporalClientObject(gf, "StartWorkflowOptions")).Block())
+					g.Add(jen.If(jen.Len(jen.Id("options")).Op(">").Lit(0).Block(
+						jen.Id("wOptions").Op("=").Id("options").Index(jen.Lit(0)),
+					)))
+					if !hasIDTemplate {
+						// a generated ID would never match the one of a running execution
+						g.Add(jen.If(jen.Id("wOptions").Dot("ID").Op("==").Lit("")).Block(
+							jen.Return(jen.Nil(), jen.Id(getErrorsObject(gf, "New")).Call(jen.Lit(fmt.Sprintf("StartOrAttach%s needs a workflow ID to attach to", method.GoName)))),
+						))
+					}
+					g.Add(jen.If(jen.Id("wOptions").Dot("WorkflowIDConflictPolicy").Op("==").Id(getTemporalEnumsObject(gf, "WORKFLOW_ID_CONFLICT_POLICY_UNSPECIFIED"))).Block(
+						jen.Id("wOptions").Dot("WorkflowIDConflictPolicy").Op("=").Id(getTemporalEnumsObject(gf, "WORKFLOW_ID_CONFLICT_POLICY_USE_EXISTING")),
+					))
+
+					g.Add(jen.List(jen.Id("future"), jen.Err()).Op(":=").Id("c").Dot(fmt.Sprintf("ExecuteWorkflow%s", method.GoName)).Call(jen.Id("ctx"), jen.Id("req"), jen.Id("wOptions")))
+
+					g.Add(IfErrNilDouble)
+
+					g.Add(jen.Return(jen.Id("c").Dot(fmt.Sprintf("Get%sFromRun", method.GoName)).Call(jen.Id("future")), jen.Nil()))
+				}).Line().Line()
+
 			// Cancels the workflow
 			workflowObjects.Comment("Cancel cancels a given workflow").Line().
 				Func().Parens(jen.Id("w").Op("*").Id(wfObjName)).Id("Cancel").ParamsFunc(func(g *jen.Group) {
@@ -270,63 +307,95 @@ func WorkflowObjects(gf *protogen.GeneratedFile, service *protogen.Service) erro
 					})))
 				}).Line().Line()
 
+			if hasMemo(method) {
+				memo, err := workflowMemo(gf, service, method, config)
+				if err != nil {
+					return err
+				}
+				workflowObjects.Add(memo)
+			}
+
+			// the calls of the workflow object run through the client interceptors
+			target := jen.List(jen.Id("c"), jen.Id("workflowID"), jen.Id("runID")).Op(":=").List(
+				jen.Id("w").Dot("c"),
+				jen.Id("w").Dot("future").Dot("GetID").Call(),
+				jen.Id("w").Dot("future").Dot("GetRunID").Call(),
+			)
+
 			if workflowOptions != nil {
 				for _, sig := range workflowOptions.Signals {
-					meth, ok := signalsMap[sig]
-					if !ok {
-						return fmt.Errorf("no signal %s defined in service %s for workflow %s", sig, service.GoName, method.GoName)
+					meth, err := config.registry.findMethod(service, sig, MethodTypeSignal)
+					if err != nil {
+						return fmt.Errorf("invalid workflow %s: %w", method.GoName, err)
 					}
 
-					sigName, err := getMethodRegisteredName(meth)
+					hook, err := getClientHook(gf, service, config, fmt.Sprintf("SendSignal%s", meth.GoName))
 					if err != nil {
 						return err
 					}
-					sigOpts, _ := proto.GetExtension(method.Desc.Options(), temporalv1.E_Signal).(*temporalv1.SignalOptions)
-
-					if sigOpts != nil && sigOpts.Name != "" {
-						sigName = sigOpts.Name
-					}
 
 					// Sends a signal to a workflow
-					workflowObjects.Comment(fmt.Sprintf("Signal%s sends the %s signal to the workflow", sig, sig)).Line().
-						Func().Parens(jen.Id("w").Op("*").Id(wfObjName)).Id("Signal" + sig).ParamsFunc(func(g *jen.Group) {
+					workflowObjects.Comment(fmt.Sprintf("Signal%s sends the %s signal to the workflow", meth.GoName, meth.GoName)).Line().
+						Func().Parens(jen.Id("w").Op("*").Id(wfObjName)).Id("Signal" + meth.GoName).ParamsFunc(func(g *jen.Group) {
 						g.Add(jen.Id("ctx").Id(getContext(gf)))
 						g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(meth.Input.GoIdent)))
 					}).ParamsFunc(func(g *jen.Group) {
 						g.Add(jen.Error())
 					}).
 						BlockFunc(func(g *jen.Group) {
-							g.Add(jen.Return(jen.Id("w").Dot("client").Dot("SignalWorkflow").CallFunc(func(g *jen.Group) {
-								g.Add(jen.Id("ctx"))
-								g.Add(jen.Id("w").Dot("future").Dot("GetID").Parens(jen.Null()))
-								g.Add(jen.Id("w").Dot("future").Dot("GetRunID").Parens(jen.Null()))
-								g.Add(jen.Lit(sigName))
-								g.Add(jen.Id("req"))
-							})))
+							g.Add(target.Clone())
+							for _, s := range hook.chain() {
+								g.Add(s)
+							}
 						}).Line().Line()
 				}
 			}
 
 			if workflowOptions != nil {
 				for _, query := range workflowOptions.Queries {
-					meth, ok := queriesMap[query]
-					if !ok {
-						return fmt.Errorf("no query %s defined in service %s for workflow %s", query, service.GoName, method.GoName)
+					meth, err := config.registry.findMethod(service, query, MethodTypeQuery)
+					if err != nil {
+						return fmt.Errorf("invalid workflow %s: %w", method.GoName, err)
 					}
 
-					queryName, err := getMethodRegisteredName(meth)
+					hook, err := getClientHook(gf, service, config, fmt.Sprintf("Query%s", meth.GoName))
 					if err != nil {
 						return err
 					}
-					queryOpts, _ := proto.GetExtension(method.Desc.Options(), temporalv1.E_Query).(*temporalv1.QueryOptions)
 
-					if queryOpts != nil && queryOpts.Name != "" {
-						queryName = queryOpts.Name
+					// Send a query to a workflow
+					workflowObjects.Comment(fmt.Sprintf("Query%s queries the workflow with %s", meth.GoName, meth.GoName)).Line().
+						Func().Parens(jen.Id("w").Op("*").Id(wfObjName)).Id("Query" + meth.GoName).ParamsFunc(func(g *jen.Group) {
+						g.Add(jen.Id("ctx").Id(getContext(gf)))
+						g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(meth.Input.GoIdent)))
+					}).ParamsFunc(func(g *jen.Group) {
+						g.Add(jen.Op("*").Id(gf.QualifiedGoIdent(meth.Output.GoIdent)))
+						g.Add(jen.Error())
+					}).
+						BlockFunc(func(g *jen.Group) {
+							g.Add(target.Clone())
+							for _, s := range hook.chain() {
+								g.Add(s)
+							}
+						}).Line().Line()
+				}
+			}
+
+			if workflowOptions != nil {
+				for _, update := range workflowOptions.Updates {
+					meth, err := config.registry.findMethod(service, update, MethodTypeUpdate)
+					if err != nil {
+						return fmt.Errorf("invalid workflow %s: %w", method.GoName, err)
 					}
 
-					// Send a query to a workflow
-					workflowObjects.Comment(fmt.Sprintf("Query%s queries the workflow with %s", query, query)).Line().
-						Func().Parens(jen.Id("w").Op("*").Id(wfObjName)).Id("Query" + query).ParamsFunc(func(g *jen.Group) {
+					hook, err := getClientHook(gf, service, config, fmt.Sprintf("Update%s", meth.GoName))
+					if err != nil {
+						return err
+					}
+
+					// Sends an update to a workflow and waits for the result
+					workflowObjects.Comment(fmt.Sprintf("Update%s sends the %s update to the workflow and waits for its result", meth.GoName, meth.GoName)).Line().
+						Func().Parens(jen.Id("w").Op("*").Id(wfObjName)).Id("Update" + meth.GoName).ParamsFunc(func(g *jen.Group) {
 						g.Add(jen.Id("ctx").Id(getContext(gf)))
 						g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(meth.Input.GoIdent)))
 					}).ParamsFunc(func(g *jen.Group) {
@@ -334,19 +403,18 @@ func WorkflowObjects(gf *protogen.GeneratedFile, service *protogen.Service) erro
 						g.Add(jen.Error())
 					}).
 						BlockFunc(func(g *jen.Group) {
-							g.Add(jen.Id("future").Op(",").Err().Op(":=").Id("w").Dot("client").Dot("QueryWorkflow").CallFunc(func(g *jen.Group) {
-								g.Add(jen.Id("ctx"))
-								g.Add(jen.Id("w").Dot("future").Dot("GetID").Parens(jen.Null()))
-								g.Add(jen.Id("w").Dot("future").Dot("GetRunID").Parens(jen.Null()))
-								g.Add(jen.Lit(queryName))
-								g.Add(jen.Id("req"))
-							}))
+							g.Add(target.Clone())
+							g.Add(jen.Id("stage").Op(":=").Id(getTemporalClientObject(gf, "WorkflowUpdateStageCompleted")))
+							for _, s := range hook.chain(jen.Id("handle"), jen.Err()) {
+								g.Add(s)
+							}
 
 							g.Add(IfErrNilDouble)
 
 							g.Add(jen.Var().Id("resp").Op("*").Id(gf.QualifiedGoIdent(meth.Output.GoIdent)))
 
-							g.Add(jen.Id("err").Op("=").Id("future").Dot("Get").CallFunc(func(g *jen.Group) {
+							g.Add(jen.Id("err").Op("=").Id("handle").Dot("Get").CallFunc(func(g *jen.Group) {
+								g.Add(jen.Id("ctx"))
 								g.Add(jen.Op("&").Id("resp"))
 							}))
 
@@ -357,6 +425,32 @@ func WorkflowObjects(gf *protogen.GeneratedFile, service *protogen.Service) erro
 								g.Add(jen.Nil())
 							}))
 						}).Line().Line()
+
+					// Sends an update to a workflow and returns a handle once accepted
+					workflowObjects.Comment(fmt.Sprintf("Update%sAsync sends the %s update to the workflow and returns a handle to it once it has been accepted", meth.GoName, meth.GoName)).Line().
+						Func().Parens(jen.Id("w").Op("*").Id(wfObjName)).Id("Update" + meth.GoName + "Async").ParamsFunc(func(g *jen.Group) {
+						g.Add(jen.Id("ctx").Id(getContext(gf)))
+						g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(meth.Input.GoIdent)))
+					}).ParamsFunc(func(g *jen.Group) {
+						g.Add(jen.Op("*").Id(getUpdateHandleName(service, meth)))
+						g.Add(jen.Error())
+					}).
+						BlockFunc(func(g *jen.Group) {
+							g.Add(target.Clone())
+							g.Add(jen.Id("stage").Op(":=").Id(getTemporalClientObject(gf, "WorkflowUpdateStageAccepted")))
+							for _, s := range hook.chain(jen.Id("handle"), jen.Err()) {
+								g.Add(s)
+							}
+
+							g.Add(IfErrNilDouble)
+
+							g.Add(jen.ReturnFunc(func(g *jen.Group) {
+								g.Add(jen.Op("&").Id(getUpdateHandleName(service, meth)).Values(jen.Dict{
+									jen.Id("handle"): jen.Id("handle"),
+								}))
+								g.Add(jen.Nil())
+							}))
+						}).Line().Line()
 				}
 			}
 
@@ -433,6 +527,19 @@ func WorkflowObjects(gf *protogen.GeneratedFile, service *protogen.Service) erro
 					g.Add(jen.Return(jen.Id("w").Dot("future")))
 				}).Line().Line()
 
+			workflowObjects.Comment("WaitStarted blocks until the child workflow is started and returns its execution").Line().
+				Func().Parens(jen.Id("w").Op("*").Id(wfChildObjName)).Id("WaitStarted").ParamsFunc(func(g *jen.Group) {
+				g.Add(jen.Id("ctx").Id(getTemporalWorkflowObject(gf, "Context")))
+			}).ParamsFunc(func(g *jen.Group) {
+				g.Add(jen.Id(getTemporalWorkflowObject(gf, "Execution")))
+				g.Add(jen.Error())
+			}).
+				BlockFunc(func(g *jen.Group) {
+					g.Add(jen.Var().Id("execution").Id(getTemporalWorkflowObject(gf, "Execution")))
+					g.Add(jen.Id("err").Op(":=").Id("w").Dot("future").Dot("GetChildWorkflowExecution").Call().Dot("Get").Call(jen.Id("ctx"), jen.Op("&").Id("execution")))
+					g.Add(jen.Return(jen.Id("execution"), jen.Err()))
+				}).Line().Line()
+
 			workflowObjects.Comment("Wraps the IsReady method from the future").Line().
 				Func().Parens(jen.Id("w").Op("*").Id(wfChildObjName)).Id("IsReady").Parens(jen.Null()).
 				ParamsFunc(func(g *jen.Group) {
@@ -460,9 +567,9 @@ func WorkflowObjects(gf *protogen.GeneratedFile, service *protogen.Service) erro
 
 			if workflowOptions != nil {
 				for _, sig := range workflowOptions.Signals {
-					meth, ok := signalsMap[sig]
-					if !ok {
-						return fmt.Errorf("no signal %s defined in service %s for workflow %s", sig, service.GoName, method.GoName)
+					meth, err := config.registry.findMethod(service, sig, MethodTypeSignal)
+					if err != nil {
+						return fmt.Errorf("invalid workflow %s: %w", method.GoName, err)
 					}
 
 					sigName, err := getMethodRegisteredName(meth)
@@ -476,8 +583,8 @@ func WorkflowObjects(gf *protogen.GeneratedFile, service *protogen.Service) erro
 					}
 
 					// Sends a signal to a workflow
-					workflowObjects.Comment(fmt.Sprintf("Signal%s sends the %s signal to the workflow", sig, sig)).Line().
-						Func().Parens(jen.Id("w").Op("*").Id(wfChildObjName)).Id("Signal" + sig).ParamsFunc(func(g *jen.Group) {
+					workflowObjects.Comment(fmt.Sprintf("Signal%s sends the %s signal to the workflow", meth.GoName, meth.GoName)).Line().
+						Func().Parens(jen.Id("w").Op("*").Id(wfChildObjName)).Id("Signal" + meth.GoName).ParamsFunc(func(g *jen.Group) {
 						g.Add(jen.Id("ctx").Id(getTemporalWorkflowObject(gf, "Context")))
 						g.Add(jen.Id("req").Op("*").Id(gf.QualifiedGoIdent(meth.Input.GoIdent)))
 					}).ParamsFunc(func(g *jen.Group) {
@@ -4,17 +4,18 @@ import (
 	"flag"
 	"fmt"
 
-	temporalv1 "github.com/thomas-maurice/protoc-gen-go-tmprl/gen/temporal/v1"
 	"github.com/thomas-maurice/protoc-gen-go-tmprl/internal/generator"
-	"github.com/thomas-maurice/protoc-gen-go-tmprl/internal/version"
 	"google.golang.org/protobuf/compiler/protogen"
-	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/pluginpb"
 )
 
 var (
-	genWorkflowPrefix bool
-	genDocs           bool
+	genWorkflowPrefix      bool
+	genDocs                bool
+	genTestEnv             bool
+	protoConverter         string
+	genValidation          bool
+	genValidationOnReceipt bool
 	// Default activity start to close timeout in seconds
 	defaultActivityScheduleToClose int
 )
@@ -25,6 +26,10 @@ func main() {
 	flags.BoolVar(&genWorkflowPrefix, "gen-workflow-prefix", false, "Generates a prefix for the jobs like foo.v1.Foo.Method/<workflowID>")
 	flags.IntVar(&defaultActivityScheduleToClose, "default-activity-schedule-to-close", 3600*24, "Default start to close activity timeout if none is specified anywhere, in seconds")
 	flags.BoolVar(&genDocs, "gen-docs", false, "Generates documentation for the temporal workflows")
+	flags.BoolVar(&genTestEnv, "gen-test-env", false, "Generates a typed test environment for the temporal workflows")
+	flags.StringVar(&protoConverter, "gen-proto-converter", "", "Generates data converters and constructors encoding the messages as `binary` protobuf or protobuf `json`")
+	flags.BoolVar(&genValidation, "gen-validation", false, "Validates the requests implementing a Validate method before starting workflows, scheduling activities and sending signals")
+	flags.BoolVar(&genValidationOnReceipt, "gen-validation-on-receipt", false, "Validates the requests of the workflows and activities again in the workers")
 	opts := &protogen.Options{
 		ParamFunc: flags.Set,
 	}
@@ -35,135 +40,35 @@ func main() {
 			return fmt.Errorf("the default schedule to close activity timeout cannot be 0 nor negative")
 		}
 
+		if protoConverter != "" && protoConverter != generator.ProtoConverterBinary && protoConverter != generator.ProtoConverterJSON {
+			return fmt.Errorf("the proto converter must be either %s or %s", generator.ProtoConverterBinary, generator.ProtoConverterJSON)
+		}
+
 		gen.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
 		for _, f := range gen.Files {
 			if !f.Generate {
 				continue
 			}
-			generateFile(gen, f, &generator.Config{
+			config := &generator.Config{
 				GenWorkflowPrefix:              genWorkflowPrefix,
+				GenDocs:                        genDocs,
+				GenTestEnv:                     genTestEnv,
 				DefaultActivityScheduleToClose: defaultActivityScheduleToClose,
-			})
+				ProtoConverter:                 protoConverter,
+				Validation:                     genValidation,
+				ValidationOnReceipt:            genValidationOnReceipt,
+			}
+			generator.GenerateFile(gen, f, config)
 			if genDocs {
-				generateReadme(gen, f, &generator.Config{
-					GenWorkflowPrefix:              genWorkflowPrefix,
-					DefaultActivityScheduleToClose: defaultActivityScheduleToClose,
-				})
+				generator.GenerateReadme(gen, f, config)
+			}
+			if genTestEnv {
+				generator.GenerateTestEnv(gen, f, config)
+			}
+			if protoConverter != "" {
+				generator.GenerateConverterTest(gen, f, config)
 			}
 		}
 		return nil
 	})
 }
-
-func generateFile(plugin *protogen.Plugin, file *protogen.File, config *generator.Config) *protogen.GeneratedFile {
-	filename := file.GeneratedFilenamePrefix + "_tmprl.pb.go"
-
-	needsGenerate := false
-	for _, s := range file.Services {
-		if so, ok := proto.GetExtension(s.Desc.Options(), temporalv1.E_Service).(*temporalv1.ServiceOptions); ok && so != nil {
-			needsGenerate = true
-		}
-	}
-
-	if !needsGenerate {
-		return nil
-	}
-
-	gen := plugin.NewGeneratedFile(filename, file.GoImportPath)
-	gen.P("// Code generated by protoc-gen-go-tmprl. DO NOT EDIT.")
-	gen.P("//")
-	gen.P("// version:")
-	gen.P("//   protoc-gen-go-tmprl version: " + version.Version)
-	gen.P("//   protoc-gen-go-tmprl commit: " + version.Commit)
-	gen.P("//")
-	gen.P("// source file: " + file.Proto.GetName())
-	gen.P()
-	gen.P("package ", file.GoPackageName)
-	gen.P()
-
-	for _, s := range file.Services {
-		if so, ok := proto.GetExtension(s.Desc.Options(), temporalv1.E_Service).(*temporalv1.ServiceOptions); !ok || so == nil {
-			// not a temporal service if the `temporal.v1.service` option is not set
-			continue
-		}
-
-		err := generator.ServiceConstants(gen, s, config)
-		if err != nil {
-			plugin.Error(err)
-		}
-
-		err = generator.UnimplementedServiceInterface(gen, s)
-		if err != nil {
-			plugin.Error(err)
-		}
-
-		err = generator.Worker(gen, s)
-		if err != nil {
-			plugin.Error(err)
-		}
-
-		err = generator.Client(gen, s, config)
-		if err != nil {
-			plugin.Error(err)
-		}
-
-		err = generator.WorkflowObjects(gen, s)
-		if err != nil {
-			plugin.Error(err)
-		}
-
-		err = generator.ServiceSignals(gen, s)
-		if err != nil {
-			plugin.Error(err)
-		}
-
-		err = generator.ServiceQueries(gen, s)
-		if err != nil {
-			plugin.Error(err)
-		}
-	}
-
-	return gen
-}
-
-func generateReadme(plugin *protogen.Plugin, file *protogen.File, config *generator.Config) *protogen.GeneratedFile {
-	filename := file.GeneratedFilenamePrefix + "_tmprl_doc.md"
-
-	needsGenerate := false
-	for _, s := range file.Services {
-		if so, ok := proto.GetExtension(s.Desc.Options(), temporalv1.E_Service).(*temporalv1.ServiceOptions); ok && so != nil {
-			needsGenerate = true
-		}
-	}
-
-	if !needsGenerate {
-		return nil
-	}
-
-	gen := plugin.NewGeneratedFile(filename, file.GoImportPath)
-	gen.P(`<a id="top"></a>`)
-	gen.P("# Services")
-	for _, s := range file.Services {
-		if so, ok := proto.GetExtension(s.Desc.Options(), temporalv1.E_Service).(*temporalv1.ServiceOptions); !ok || so == nil {
-			// not a temporal service if the `temporal.v1.service` option is not set
-			continue
-		}
-
-		err := generator.ReadmeService(gen, s, config)
-		if err != nil {
-			plugin.Error(err)
-		}
-	}
-
-	gen.P("# Messages")
-	for _, m := range file.Messages {
-		err := generator.ReadmeMessage(gen, m, config)
-		if err != nil {
-			plugin.Error(err)
-		}
-	}
-
-	gen.P("\n\n[Back to top](#top)")
-
-	return gen
-}